@@ -2,13 +2,14 @@ package usergrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/google/uuid"
 	"github.com/yolkhovyy/go-userv/contract/dto"
 	"github.com/yolkhovyy/go-userv/contract/proto"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Client struct {
@@ -16,8 +17,20 @@ type Client struct {
 	client proto.UserServiceClient
 }
 
-func NewClient(address string) (*Client, error) {
-	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewClient creates a user service client. By default calls are traced,
+// idempotent calls are retried on UNAVAILABLE and calls time out after DefaultTimeout.
+func NewClient(address string, opts ...Option) (*Client, error) {
+	options := defaultOptions()
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	dialOptions, err := options.dial()
+	if err != nil {
+		return nil, fmt.Errorf("gRPC client options: %w", err)
+	}
+
+	conn, err := grpc.NewClient(address, dialOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to gRPC server: %w", err)
 	}
@@ -146,3 +159,33 @@ func (c *Client) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+// Export streams all users of the country, or all users if empty, to yield.
+// It stops at the first error returned by yield. The default timeout does not
+// apply to the stream, bound it with the context if needed.
+func (c *Client) Export(ctx context.Context, country string, yield func(dto.User) error) error {
+	stream, err := c.client.Export(ctx, &proto.ExportRequest{Country: country})
+	if err != nil {
+		return fmt.Errorf("export users: %w", err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("export users: %w", err)
+		}
+
+		user, err := dtoUserFromProto(resp)
+		if err != nil {
+			return fmt.Errorf("export users: %w", err)
+		}
+
+		if err := yield(*user); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package usergrpc
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type flakyServer struct {
+	proto.UnimplementedUserServiceServer
+
+	failures      int32
+	calls         atomic.Int32
+	authorization atomic.Value
+	deadline      atomic.Bool
+}
+
+func (s *flakyServer) Get(ctx context.Context, req *proto.UserID) (*proto.User, error) {
+	if s.calls.Add(1) <= s.failures {
+		return nil, status.Error(codes.Unavailable, "try again")
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		s.authorization.Store(md.Get("authorization"))
+	}
+
+	_, ok := ctx.Deadline()
+	s.deadline.Store(ok)
+
+	return &proto.User{Id: req.GetId()}, nil
+}
+
+func (s *flakyServer) Create(context.Context, *proto.UserInput) (*proto.User, error) {
+	s.calls.Add(1)
+
+	return nil, status.Error(codes.Unavailable, "try again")
+}
+
+func TestClient_Options(t *testing.T) {
+	t.Parallel()
+
+	service := &flakyServer{failures: 2}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	proto.RegisterUserServiceServer(server, service)
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	client, err := NewClient("passthrough:///bufnet",
+		WithTimeout(time.Second),
+		WithBearerToken("secret"),
+		WithInsecureCredentials(),
+		WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = client.Close() })
+
+	userID := uuid.New()
+
+	user, err := client.Get(context.Background(), userID)
+	require.NoError(t, err)
+	assert.Equal(t, userID, user.ID)
+
+	assert.Equal(t, int32(3), service.calls.Load())
+	assert.Equal(t, []string{"Bearer secret"}, service.authorization.Load())
+	assert.True(t, service.deadline.Load())
+
+	// Create is not idempotent, it is not retried.
+	service.calls.Store(0)
+
+	_, err = client.Create(context.Background(), dto.UserInput{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, int32(1), service.calls.Load())
+}
+
+func TestClient_BearerTokenRequiresTLS(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClient("passthrough:///bufnet", WithBearerToken("secret"))
+	require.Error(t, err)
+
+	client, err := NewClient("passthrough:///bufnet", WithBearerToken("secret"), WithInsecureCredentials())
+	require.NoError(t, err)
+	require.NoError(t, client.Close())
+}
+
+func TestOptions_ServiceConfig(t *testing.T) {
+	t.Parallel()
+
+	options := defaultOptions()
+	WithRoundRobin()(&options)
+
+	config, err := options.serviceConfig()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"loadBalancingConfig": [{"round_robin": {}}],
+		"methodConfig": [{
+			"name": [
+				{"service": "user.UserService", "method": "Get"},
+				{"service": "user.UserService", "method": "List"},
+				{"service": "user.UserService", "method": "Export"},
+				{"service": "user.UserService", "method": "Delete"}
+			],
+			"retryPolicy": {
+				"maxAttempts": 4,
+				"initialBackoff": "0.1s",
+				"maxBackoff": "2s",
+				"backoffMultiplier": 2,
+				"retryableStatusCodes": ["UNAVAILABLE"]
+			}
+		}]
+	}`, config)
+
+	WithRetryPolicy(RetryPolicy{})(&options)
+
+	config, err = options.serviceConfig()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"loadBalancingConfig": [{"round_robin": {}}]}`, config)
+}
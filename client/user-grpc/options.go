@@ -0,0 +1,267 @@
+package usergrpc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Option configures the gRPC client.
+type Option func(*options)
+
+type options struct {
+	timeout     time.Duration
+	retry       RetryPolicy
+	roundRobin  bool
+	tlsConfig   *tls.Config
+	perRPCCreds credentials.PerRPCCredentials
+	insecure    bool
+	otelOptions []otelgrpc.Option
+	dialOptions []grpc.DialOption
+}
+
+// RetryPolicy configures transparent retries of idempotent calls failing with
+// UNAVAILABLE, Create and Update are never retried.
+// Retries are disabled when MaxAttempts is less than 2.
+type RetryPolicy struct {
+	MaxAttempts       int
+	InitialBackoff    time.Duration
+	MaxBackoff        time.Duration
+	BackoffMultiplier float64
+}
+
+const (
+	// DefaultTimeout is applied to calls whose context has no deadline.
+	DefaultTimeout = 5 * time.Second
+	// Default retry policy.
+	DefaultMaxAttempts       = 4
+	DefaultInitialBackoff    = 100 * time.Millisecond
+	DefaultMaxBackoff        = 2 * time.Second
+	DefaultBackoffMultiplier = 2.0
+)
+
+func defaultOptions() options {
+	return options{
+		timeout: DefaultTimeout,
+		retry: RetryPolicy{
+			MaxAttempts:       DefaultMaxAttempts,
+			InitialBackoff:    DefaultInitialBackoff,
+			MaxBackoff:        DefaultMaxBackoff,
+			BackoffMultiplier: DefaultBackoffMultiplier,
+		},
+	}
+}
+
+// WithTimeout sets the default per-call timeout, applied when the call
+// context has no deadline. Zero disables the default timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetryPolicy replaces the default retry policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithRoundRobin balances calls over all addresses the target resolves to.
+// With the default dns resolver use a target like dns:///user-grpc:50051.
+func WithRoundRobin() Option {
+	return func(o *options) {
+		o.roundRobin = true
+	}
+}
+
+// WithTLS enables TLS transport credentials, the connection is insecure otherwise.
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = config
+	}
+}
+
+// WithPerRPCCredentials attaches credentials to every call.
+func WithPerRPCCredentials(creds credentials.PerRPCCredentials) Option {
+	return func(o *options) {
+		o.perRPCCreds = creds
+	}
+}
+
+// WithBearerToken sends the token in the authorization metadata of every call.
+// The token requires TLS, see WithInsecureCredentials.
+func WithBearerToken(token string) Option {
+	return func(o *options) {
+		o.perRPCCreds = bearerToken{token: token}
+	}
+}
+
+// WithInsecureCredentials allows the bearer token to be sent over a plaintext
+// connection, e.g. when the traffic is otherwise protected by a service mesh.
+func WithInsecureCredentials() Option {
+	return func(o *options) {
+		o.insecure = true
+	}
+}
+
+// WithOtelOptions configures the OpenTelemetry stats handler.
+func WithOtelOptions(opts ...otelgrpc.Option) Option {
+	return func(o *options) {
+		o.otelOptions = append(o.otelOptions, opts...)
+	}
+}
+
+// WithDialOptions appends raw dial options, applied after the ones above.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
+
+func (o options) dial() ([]grpc.DialOption, error) {
+	serviceConfig, err := o.serviceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	transportCreds := insecure.NewCredentials()
+	if o.tlsConfig != nil {
+		transportCreds = credentials.NewTLS(o.tlsConfig)
+	}
+
+	otelOptions := append([]otelgrpc.Option{
+		otelgrpc.WithTracerProvider(otel.GetTracerProvider()),
+	}, o.otelOptions...)
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(transportCreds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelOptions...)),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}
+
+	if o.timeout > 0 {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(timeoutInterceptor(o.timeout)))
+	}
+
+	if token, ok := o.perRPCCreds.(bearerToken); ok {
+		token.insecure = o.insecure
+		opts = append(opts, grpc.WithPerRPCCredentials(token))
+	} else if o.perRPCCreds != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(o.perRPCCreds))
+	}
+
+	return append(opts, o.dialOptions...), nil
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig,omitempty"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method,omitempty"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+const serviceName = "user.UserService"
+
+// retryableMethods are safe to call again after a failed attempt. Create and
+// Update are not, a retry could repeat a change the server already applied.
+//
+//nolint:gochecknoglobals
+var retryableMethods = []string{"Get", "List", "Export", "Delete"}
+
+func (o options) serviceConfig() (string, error) {
+	var config serviceConfig
+
+	if o.roundRobin {
+		config.LoadBalancingConfig = []map[string]struct{}{{"round_robin": {}}}
+	}
+
+	if o.retry.MaxAttempts > 1 {
+		names := make([]methodName, 0, len(retryableMethods))
+		for _, method := range retryableMethods {
+			names = append(names, methodName{Service: serviceName, Method: method})
+		}
+
+		config.MethodConfig = []methodConfig{{
+			Name: names,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          o.retry.MaxAttempts,
+				InitialBackoff:       durationJSON(o.retry.InitialBackoff),
+				MaxBackoff:           durationJSON(o.retry.MaxBackoff),
+				BackoffMultiplier:    o.retry.BackoffMultiplier,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}}
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("service config marshal: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// durationJSON formats a duration as a protobuf JSON duration.
+func durationJSON(d time.Duration) string {
+	return fmt.Sprintf("%gs", d.Seconds())
+}
+
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, conn, opts...)
+	}
+}
+
+// bearerToken is a static per-RPC bearer token. It is only sent over TLS
+// connections unless insecure is set.
+type bearerToken struct {
+	token    string
+	insecure bool
+}
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + t.token}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return !t.insecure
+}
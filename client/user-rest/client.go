@@ -5,7 +5,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -64,7 +63,7 @@ func (c *Client) Create(ctx context.Context, user dto.UserInput) (*dto.User, err
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("create: %w %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, fmt.Errorf("create: %w", responseError(resp))
 	}
 
 	var createdUser dto.User
@@ -99,7 +98,7 @@ func (c *Client) Get(ctx context.Context, userID uuid.UUID) (*dto.User, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("get: %w %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, fmt.Errorf("get: %w", responseError(resp))
 	}
 
 	var user dto.User
@@ -143,7 +142,7 @@ func (c *Client) List(ctx context.Context, page, limit int, countryCode string)
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("list: %w %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, fmt.Errorf("list: %w", responseError(resp))
 	}
 
 	var userList dto.UserList
@@ -186,7 +185,7 @@ func (c *Client) Update(ctx context.Context, user dto.UserUpdate) (*dto.User, er
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("update: %w %d", ErrUnexpectedStatusCode, resp.StatusCode)
+		return nil, fmt.Errorf("update: %w", responseError(resp))
 	}
 
 	var updatedUser dto.User
@@ -222,9 +221,7 @@ func (c *Client) Delete(ctx context.Context, userID uuid.UUID) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(resp.Body)
-
-		return fmt.Errorf("delete: %w %d, body: %s", ErrUnexpectedStatusCode, resp.StatusCode, string(body))
+		return fmt.Errorf("delete: %w", responseError(resp))
 	}
 
 	return nil
@@ -0,0 +1,90 @@
+package userrest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+)
+
+func TestClient_Problems(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expected    error
+		problem     bool
+	}{
+		{
+			name:        "not found",
+			contentType: dto.ProblemContentType,
+			body: `{"type":"` + dto.ProblemTypeBase + `user_not_found","title":"User not found","status":404,` +
+				`"code":"USER_NOT_FOUND","requestId":"request-42"}`,
+			expected: ErrNotFound,
+			problem:  true,
+		},
+		{
+			name:        "validation failed",
+			contentType: dto.ProblemContentType + "; charset=utf-8",
+			body: `{"title":"Validation failed","status":400,"code":"VALIDATION_FAILED",` +
+				`"errors":[{"field":"id","detail":"must be a UUID"}]}`,
+			expected: ErrValidation,
+			problem:  true,
+		},
+		{
+			name:        "plain text",
+			contentType: "text/plain",
+			body:        "bad gateway",
+			expected:    ErrUnexpectedStatusCode,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				writer.Header().Set("Content-Type", test.contentType)
+				writer.WriteHeader(http.StatusBadRequest)
+				_, _ = writer.Write([]byte(test.body))
+			}))
+			t.Cleanup(server.Close)
+
+			_, err := NewClient(server.URL).Get(context.Background(), uuid.New())
+			require.ErrorIs(t, err, test.expected)
+			require.ErrorIs(t, err, ErrUnexpectedStatusCode)
+
+			var problemErr *ProblemError
+			assert.Equal(t, test.problem, errors.As(err, &problemErr))
+		})
+	}
+}
+
+func TestClient_ProblemFields(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", dto.ProblemContentType)
+		writer.WriteHeader(http.StatusBadRequest)
+		_, _ = writer.Write([]byte(`{"status":400,"code":"VALIDATION_FAILED","requestId":"request-42",` +
+			`"errors":[{"field":"email","detail":"must be a valid email address"}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := NewClient(server.URL).Create(context.Background(), dto.UserInput{})
+
+	var problemErr *ProblemError
+	require.ErrorAs(t, err, &problemErr)
+	assert.Equal(t, "request-42", problemErr.RequestID)
+	require.Len(t, problemErr.Errors, 1)
+	assert.Equal(t, "email", problemErr.Errors[0].Field)
+	assert.NotErrorIs(t, err, ErrNotFound)
+}
@@ -1,5 +1,94 @@
 package userrest
 
-import "errors"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
 
-var ErrUnexpectedStatusCode = errors.New("unexpected status code")
+	"github.com/yolkhovyy/go-userv/contract/dto"
+)
+
+var (
+	ErrUnexpectedStatusCode = errors.New("unexpected status code")
+	ErrValidation           = errors.New("validation failed")
+	ErrMalformedRequest     = errors.New("malformed request")
+	ErrNotFound             = errors.New("not found")
+	ErrConflict             = errors.New("conflict")
+	ErrInternal             = errors.New("internal error")
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused")
+	ErrRateLimited          = errors.New("rate limited")
+	ErrRequestTooLarge      = errors.New("request too large")
+	ErrTimeout              = errors.New("request timeout")
+)
+
+// ProblemError is a problem details response of the service.
+// errors.Is matches it against the error of its code and ErrUnexpectedStatusCode.
+type ProblemError struct {
+	dto.Problem
+	// RetryAfter is the Retry-After header of rate limited responses.
+	RetryAfter time.Duration
+}
+
+func (e *ProblemError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%d %s: %s (request id %s)", e.Status, e.Code, e.Detail, e.RequestID)
+	}
+
+	return fmt.Sprintf("%d %s: %s", e.Status, e.Code, e.Detail)
+}
+
+func (e *ProblemError) Is(target error) bool {
+	if target == ErrUnexpectedStatusCode { //nolint:errorlint
+		return true
+	}
+
+	switch e.Code {
+	case dto.CodeValidationFailed:
+		return target == ErrValidation //nolint:errorlint
+	case dto.CodeMalformedRequest:
+		return target == ErrMalformedRequest //nolint:errorlint
+	case dto.CodeUserNotFound, dto.CodeRouteNotFound:
+		return target == ErrNotFound //nolint:errorlint
+	case dto.CodeUserConflict, dto.CodeIdempotencyKeyInProgress:
+		return target == ErrConflict //nolint:errorlint
+	case dto.CodeIdempotencyKeyReused:
+		return target == ErrIdempotencyKeyReused //nolint:errorlint
+	case dto.CodeInternalError:
+		return target == ErrInternal //nolint:errorlint
+	case dto.CodeRateLimited:
+		return target == ErrRateLimited //nolint:errorlint
+	case dto.CodeRequestTooLarge:
+		return target == ErrRequestTooLarge //nolint:errorlint
+	case dto.CodeRequestTimeout:
+		return target == ErrTimeout //nolint:errorlint
+	}
+
+	return false
+}
+
+// responseError returns a *ProblemError for problem details responses,
+// an ErrUnexpectedStatusCode error with the response body otherwise.
+func responseError(resp *http.Response) error {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == dto.ProblemContentType {
+		var problem ProblemError
+		if err := json.NewDecoder(resp.Body).Decode(&problem.Problem); err != nil {
+			return fmt.Errorf("%w %d, decode problem: %w", ErrUnexpectedStatusCode, resp.StatusCode, err)
+		}
+
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			problem.RetryAfter = time.Duration(seconds) * time.Second
+		}
+
+		return &problem
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+
+	return fmt.Errorf("%w %d, body: %s", ErrUnexpectedStatusCode, resp.StatusCode, string(body))
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yolkhovyy/go-otelw/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/notifier"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	ginrouter "github.com/yolkhovyy/go-userv/internal/router/gin"
+	gqlrouter "github.com/yolkhovyy/go-userv/internal/router/graphql"
+	grpcrouter "github.com/yolkhovyy/go-userv/internal/router/grpc"
+	grpcserver "github.com/yolkhovyy/go-userv/internal/server/grpc"
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
+	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
+	"github.com/yolkhovyy/go-utilities/viperx"
+)
+
+type Config struct {
+	otelw.Config `yaml:",inline" mapstructure:",squash"`
+	App          app.Config         `yaml:"app" mapstructure:"App"`
+	Mux          MuxConfig          `yaml:"mux" mapstructure:"Mux"`
+	HTTP         httpserver.Config  `yaml:"http" mapstructure:"HTTP"`
+	Router       ginrouter.Config   `yaml:"router" mapstructure:"Router"`
+	GraphQLHTTP  httpserver.Config  `yaml:"graphqlHttp" mapstructure:"GraphQLHTTP"`
+	GraphQL      gqlrouter.Config   `yaml:"graphql" mapstructure:"GraphQL"`
+	GRPC         grpcserver.Config  `yaml:"grpc" mapstructure:"GRPC"`
+	Connect      httpserver.Config  `yaml:"connect" mapstructure:"Connect"`
+	GRPCRouter   grpcrouter.Config  `yaml:"grpcRouter" mapstructure:"GRPCRouter"`
+	Notifier     NotifierConfig     `yaml:"notifier" mapstructure:"Notifier"`
+	Postgres     postgres.Config    `yaml:"postgres" mapstructure:"Postgres"`
+	Admin        admin.Config       `yaml:"admin" mapstructure:"Admin"`
+	Health       health.Config      `yaml:"health" mapstructure:"Health"`
+	RateLimit    ratelimit.Config   `yaml:"rateLimit" mapstructure:"RateLimit"`
+	Idempotency  idempotency.Config `yaml:"idempotency" mapstructure:"Idempotency"`
+}
+
+// MuxConfig serves REST, GraphQL, gRPC and Connect on one port instead of
+// the http, graphqlHttp, grpc and connect ports.
+type MuxConfig struct {
+	Enable            bool `yaml:"enable" mapstructure:"Enable"`
+	httpserver.Config `yaml:",inline" mapstructure:",squash"`
+}
+
+// NotifierConfig runs the Kafka notifier along with the APIs.
+type NotifierConfig struct {
+	Enable          bool `yaml:"enable" mapstructure:"Enable"`
+	notifier.Config `yaml:",inline" mapstructure:",squash"`
+}
+
+func NewConfig() *Config {
+	return &Config{}
+}
+
+func (c *Config) Load(configFile string, prefix string) error {
+	vprx := viperx.New(configFile, prefix, nil)
+
+	vprx.SetDefaults(otelw.Defaults())
+	vprx.SetDefaults(app.Defaults())
+	vprx.SetDefaults(muxDefaults())
+	vprx.SetDefaults(httpserver.Defaults())
+	vprx.SetDefaults(ginrouter.Defaults())
+	vprx.SetDefaults(rekey(httpserver.Defaults(), "HTTP.", "GraphQLHTTP."))
+	vprx.SetDefaults(graphQLHTTPDefaults())
+	vprx.SetDefaults(gqlrouter.Defaults())
+	vprx.SetDefaults(grpcserver.Defaults())
+	vprx.SetDefaults(rekey(httpserver.Defaults(), "HTTP.", "Connect."))
+	vprx.SetDefaults(connectDefaults())
+	vprx.SetDefaults(rekey(grpcrouter.Defaults(), "Router.", "GRPCRouter."))
+	vprx.SetDefaults(rekey(notifier.Defaults(), "Kafka.", "Notifier."))
+	vprx.SetDefaults(postgres.Defaults())
+	vprx.SetDefaults(health.Defaults())
+	vprx.SetDefaults(admin.Defaults())
+	vprx.SetDefaults(ratelimit.Defaults())
+	vprx.SetDefaults(idempotency.Defaults())
+
+	if err := vprx.Load(c); err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	return nil
+}
+
+// rekey moves defaults to another section, e.g. the HTTP server
+// defaults to the GraphQL HTTP server.
+func rekey(defaults map[string]any, from, to string) map[string]any {
+	rekeyed := make(map[string]any, len(defaults))
+
+	for key, value := range defaults {
+		rekeyed[to+strings.TrimPrefix(key, from)] = value
+	}
+
+	return rekeyed
+}
+
+func muxDefaults() map[string]any {
+	defaults := rekey(httpserver.Defaults(), "HTTP.", "Mux.")
+	defaults["Mux.Enable"] = false
+	defaults["Mux.H2C"] = true
+
+	return defaults
+}
+
+func graphQLHTTPDefaults() map[string]any {
+	return map[string]any{
+		"GraphQLHTTP.Port": defaultGraphQLPort,
+	}
+}
+
+func connectDefaults() map[string]any {
+	return map[string]any{
+		"Connect.Port": defaultConnectPort,
+	}
+}
+
+const (
+	defaultGraphQLPort = 8081
+	defaultConnectPort = 8082
+)
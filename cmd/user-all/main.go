@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yolkhovyy/go-userv/cmd/user-all/version"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/notifier"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	ginrouter "github.com/yolkhovyy/go-userv/internal/router/gin"
+	gqlrouter "github.com/yolkhovyy/go-userv/internal/router/graphql"
+	grpcrouter "github.com/yolkhovyy/go-userv/internal/router/grpc"
+	grpcserver "github.com/yolkhovyy/go-userv/internal/server/grpc"
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
+	"github.com/yolkhovyy/go-utilities/buildinfo"
+	"github.com/yolkhovyy/go-utilities/osx"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"google.golang.org/grpc"
+)
+
+const (
+	domainName  = "user"
+	serviceName = "user-all"
+	routersName = "routers"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+//nolint:funlen
+func run() int {
+	buildInfo := buildinfo.ReadData()
+
+	// Config file.
+	configFile := flag.String("config", "config.yml",
+		"Path to the configuration file (default: config.yml)")
+
+	flag.Parse()
+
+	// Service configuration.
+	config := NewConfig()
+
+	err := config.Load(*configFile, domainName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "config load: %v", err)
+
+		return osx.ExitConfigError
+	}
+
+	telemetry := app.NewTelemetry(config.Config, admin.BuildInfo{
+		Service:  serviceName,
+		Version:  version.Tag,
+		Revision: buildInfo.Revision,
+		Time:     buildInfo.Time,
+	})
+
+	// Liveness and readiness checks, readiness fails once shutdown starts.
+	registry := health.New(config.Health)
+
+	// User domain, rate limits and idempotency keys are shared by all APIs.
+	domain := app.NewDomain(config.Postgres, registry)
+	limiter := app.NewRateLimiter(config.RateLimit)
+	store := app.NewIdempotencyStore(config.Idempotency, config.Postgres)
+
+	// GraphQL subscriptions are fed from storage changes.
+	broadcaster := app.NewBroadcaster(config.GraphQL.Subscriptions.Enable, config.Postgres)
+
+	application := app.New(config.App)
+	application.Add(
+		telemetry.Component(),
+		app.Health(config.Health.Server, registry),
+		domain.Component(),
+		limiter.Component(),
+		store.Component(),
+		broadcaster.Component(),
+		app.Admin(config.Admin, telemetry, config, registry),
+	)
+
+	// Notify consumers of user changes via Kafka.
+	if config.Notifier.Enable {
+		registry.AddReadiness("kafka", notifier.KafkaChecker(config.Notifier.Config))
+
+		application.Add(app.Server("notifier", func(context.Context) (server.Contract, error) {
+			return notifier.New(config.Postgres, config.Notifier.Config)
+		}, app.TelemetryName))
+	}
+
+	// Routers are shared by the API servers.
+	var (
+		apis        apiHandlers
+		grpcOptions []grpc.ServerOption
+	)
+
+	grpcRouter := grpcrouter.New(config.GRPCRouter, domain)
+
+	application.Add(app.Component{
+		Name:      routersName,
+		DependsOn: []string{app.DomainName, app.RateLimitName, app.IdempotencyName, app.BroadcasterName},
+		Start: func(context.Context) error {
+			var (
+				handlers     = []gin.HandlerFunc{otelgin.Middleware(serviceName)}
+				interceptors grpcrouter.Interceptors
+				options      = []gqlrouter.Option{gqlrouter.WithHealth(registry)}
+				subscriber   gqlrouter.Subscriber
+			)
+
+			// Clients behind trusted proxies are identified by X-Forwarded-For.
+			proxies, err := ratelimit.ParseProxies(config.Router.TrustedProxies)
+			if err != nil {
+				return fmt.Errorf("trusted proxies: %w", err)
+			}
+
+			// Limit the request rate of clients, the limits are shared by the APIs.
+			if limiter.Limiter != nil {
+				handlers = append(handlers, ginrouter.RateLimit(limiter.Limiter, proxies))
+				interceptors.Unary = append(interceptors.Unary, grpcrouter.RateLimitInterceptor(limiter.Limiter))
+				interceptors.Stream = append(interceptors.Stream, grpcrouter.RateLimitStreamInterceptor(limiter.Limiter))
+				options = append(options, gqlrouter.WithRateLimiter(limiter.Limiter))
+			}
+
+			// Replay responses of REST requests, gRPC and Connect calls retried with an idempotency key.
+			if store.Store != nil {
+				handlers = append(handlers, ginrouter.Idempotency(store.Store, limiter.Limiter, proxies))
+				interceptors.Unary = append(interceptors.Unary, grpcrouter.IdempotencyInterceptor(store.Store, limiter.Limiter))
+			}
+
+			if broadcaster.Broadcaster != nil {
+				subscriber = broadcaster.Broadcaster
+			}
+
+			restRouter, err := ginrouter.New(config.Router, domain, registry, handlers...)
+			if err != nil {
+				return fmt.Errorf("rest router: %w", err)
+			}
+
+			graphqlRouter, err := gqlrouter.New(config.GraphQL, domain, subscriber, options...)
+			if err != nil {
+				return fmt.Errorf("graphql router: %w", err)
+			}
+
+			grpcOptions = grpcrouter.Options(interceptors)
+
+			apis = apiHandlers{
+				rest:    restRouter.Handler(),
+				graphql: graphqlRouter.Handler(),
+			}
+
+			if config.GRPCRouter.Connect {
+				apis.connect = grpcRouter.ConnectHandler(interceptors)
+			}
+
+			return nil
+		},
+	})
+
+	// API servers on one port or on separate ports.
+	if config.Mux.Enable {
+		application.Add(app.Server("mux server", func(context.Context) (server.Contract, error) {
+			apis.grpc = grpcserver.NewHandler(config.GRPC, grpcRouter, grpcOptions...)
+
+			return httpserver.New(config.Mux.Config, muxHandler(apis, config.GraphQL.Path)), nil
+		}, routersName))
+	} else {
+		application.Add(
+			app.Server("http server", func(context.Context) (server.Contract, error) {
+				return httpserver.New(config.HTTP, apis.rest), nil
+			}, routersName),
+			app.Server("graphql server", func(context.Context) (server.Contract, error) {
+				return httpserver.New(config.GraphQLHTTP, apis.graphql), nil
+			}, routersName),
+			app.Server("grpc server", func(context.Context) (server.Contract, error) {
+				return grpcserver.New(config.GRPC, grpcRouter, grpcOptions...), nil
+			}, routersName),
+		)
+
+		if config.GRPCRouter.Connect {
+			application.Add(app.Server("connect server", func(context.Context) (server.Contract, error) {
+				return httpserver.New(config.Connect, apis.connect), nil
+			}, routersName))
+		}
+	}
+
+	return application.Run(context.Background())
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/yolkhovyy/go-userv/contract/proto/protoconnect"
+)
+
+// apiHandlers serve the APIs, connect is nil when Connect is disabled.
+type apiHandlers struct {
+	rest    http.Handler
+	graphql http.Handler
+	grpc    http.Handler
+	connect http.Handler
+}
+
+// muxHandler serves the APIs on one port: gRPC calls by content type, Connect
+// and gRPC-Web calls by service path, GraphQL and its metrics by path and
+// REST otherwise. gRPC needs HTTP/2, i.e. h2c or TLS.
+func muxHandler(handlers apiHandlers, graphqlPath string) http.Handler {
+	servicePath := "/" + protoconnect.UserServiceName + "/"
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		contentType := request.Header.Get("Content-Type")
+		path := request.URL.Path
+
+		switch {
+		case request.ProtoMajor == 2 && strings.HasPrefix(contentType, "application/grpc") &&
+			!strings.HasPrefix(contentType, "application/grpc-web"):
+			handlers.grpc.ServeHTTP(writer, request)
+		case handlers.connect != nil && strings.HasPrefix(path, servicePath):
+			handlers.connect.ServeHTTP(writer, request)
+		case path == graphqlPath || strings.HasPrefix(path, graphqlPath+"/") || path == "/metrics":
+			handlers.graphql.ServeHTTP(writer, request)
+		default:
+			handlers.rest.ServeHTTP(writer, request)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuxHandler(t *testing.T) {
+	t.Parallel()
+
+	named := func(name string) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(writer, name)
+		})
+	}
+
+	handlers := apiHandlers{
+		rest:    named("rest"),
+		graphql: named("graphql"),
+		grpc:    named("grpc"),
+		connect: named("connect"),
+	}
+
+	serve := func(handlers apiHandlers, method, target, contentType string, protoMajor int) string {
+		request := httptest.NewRequest(method, target, nil)
+		request.Header.Set("Content-Type", contentType)
+		request.ProtoMajor = protoMajor
+
+		recorder := httptest.NewRecorder()
+		muxHandler(handlers, "/api/v1/graphql").ServeHTTP(recorder, request)
+
+		return recorder.Body.String()
+	}
+
+	create := "/user.UserService/Create"
+
+	assert.Equal(t, "grpc", serve(handlers, http.MethodPost, create, "application/grpc+proto", 2))
+	assert.Equal(t, "connect", serve(handlers, http.MethodPost, create, "application/grpc-web+proto", 2))
+	assert.Equal(t, "connect", serve(handlers, http.MethodPost, create, "application/proto", 1))
+	assert.Equal(t, "graphql", serve(handlers, http.MethodPost, "/api/v1/graphql", "application/json", 1))
+	assert.Equal(t, "graphql", serve(handlers, http.MethodGet, "/api/v1/graphql/schema", "", 1))
+	assert.Equal(t, "graphql", serve(handlers, http.MethodGet, "/metrics", "", 1))
+	assert.Equal(t, "rest", serve(handlers, http.MethodGet, "/api/v1/users", "", 2))
+	assert.Equal(t, "rest", serve(handlers, http.MethodGet, "/readyz", "", 1))
+
+	// Without Connect, service paths are not found by the REST router.
+	handlers.connect = nil
+	assert.Equal(t, "rest", serve(handlers, http.MethodPost, create, "application/proto", 1))
+}
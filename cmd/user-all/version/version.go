@@ -0,0 +1,4 @@
+package version
+
+//nolint:gochecknoglobals
+var Tag string
@@ -4,6 +4,10 @@ import (
 	"fmt"
 
 	"github.com/yolkhovyy/go-otelw/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
 	gqlrouter "github.com/yolkhovyy/go-userv/internal/router/graphql"
 	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
 	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
@@ -12,9 +16,13 @@ import (
 
 type Config struct {
 	otelw.Config `yaml:",inline" mapstructure:",squash"`
+	App          app.Config        `yaml:"app" mapstructure:"App"`
 	HTTP         httpserver.Config `yaml:"http" mapstructure:"HTTP"`
 	Router       gqlrouter.Config  `yaml:"graphql" mapstructure:"GraphQL"`
 	Postgres     postgres.Config   `yaml:"postgres" mapstructure:"Postgres"`
+	Admin        admin.Config      `yaml:"admin" mapstructure:"Admin"`
+	Health       health.Config     `yaml:"health" mapstructure:"Health"`
+	RateLimit    ratelimit.Config  `yaml:"rateLimit" mapstructure:"RateLimit"`
 }
 
 func NewConfig() *Config {
@@ -25,8 +33,13 @@ func (c *Config) Load(configFile string, prefix string) error {
 	vprx := viperx.New(configFile, prefix, nil)
 
 	vprx.SetDefaults(otelw.Defaults())
+	vprx.SetDefaults(app.Defaults())
 	vprx.SetDefaults(httpserver.Defaults())
+	vprx.SetDefaults(gqlrouter.Defaults())
 	vprx.SetDefaults(postgres.Defaults())
+	vprx.SetDefaults(health.Defaults())
+	vprx.SetDefaults(admin.Defaults())
+	vprx.SetDefaults(ratelimit.Defaults())
 
 	if err := vprx.Load(c); err != nil {
 		return fmt.Errorf("load config: %w", err)
@@ -2,23 +2,19 @@ package main
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
-	"log/slog"
 	"os"
-	"os/signal"
-	"syscall"
 
 	"github.com/yolkhovyy/go-userv/cmd/user-graphql/version"
-	"github.com/yolkhovyy/go-userv/internal/domain"
-	"github.com/yolkhovyy/go-userv/internal/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-userv/internal/health"
 	gqlrouter "github.com/yolkhovyy/go-userv/internal/router/graphql"
 	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
 	"github.com/yolkhovyy/go-utilities/buildinfo"
 	"github.com/yolkhovyy/go-utilities/osx"
-	"go.opentelemetry.io/otel/attribute"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 )
 
 const (
@@ -34,7 +30,7 @@ func main() {
 func run() int {
 	buildInfo := buildinfo.ReadData()
 
-	// Congig file.
+	// Config file.
 	configFile := flag.String("config", "config.yml",
 		"Path to the configuration file (default: config.yml)")
 
@@ -50,78 +46,52 @@ func run() int {
 		return osx.ExitConfigError
 	}
 
-	// The ctx.Done() channel will close when one of the signals arrives.
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
-	defer stop()
-
-	// Telemetry.
-	serviceAttributes := []attribute.KeyValue{
-		semconv.ServiceNameKey.String(serviceName),
-		semconv.ServiceVersionKey.String(version.Tag),
-	}
-
-	logger, tracer, metric, err := otelw.Configure(ctx, config.Config, serviceAttributes)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "otelw configure: %v", err)
-
-		return osx.ExitFailure
-	}
-
-	defer func() {
-		err := errors.Join(err,
-			metric.Shutdown(ctx),
-			tracer.Shutdown(ctx),
-			logger.Shutdown(ctx))
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "otelw shutdown: %v", err)
-		}
-	}()
-
-	logger.InfoContext(ctx, "build info",
-		slog.String("version", version.Tag),
-		slog.String("time", buildInfo.Time),
-		slog.String("commit", buildInfo.Revision),
+	telemetry := app.NewTelemetry(config.Config, admin.BuildInfo{
+		Service:  serviceName,
+		Version:  version.Tag,
+		Revision: buildInfo.Revision,
+		Time:     buildInfo.Time,
+	})
+
+	// Liveness and readiness checks, readiness fails once shutdown starts.
+	registry := health.New(config.Health)
+
+	domain := app.NewDomain(config.Postgres, registry)
+	limiter := app.NewRateLimiter(config.RateLimit)
+
+	// Subscriptions are fed from storage changes.
+	broadcaster := app.NewBroadcaster(config.Router.Subscriptions.Enable, config.Postgres)
+
+	application := app.New(config.App)
+	application.Add(
+		telemetry.Component(),
+		app.Health(config.Health.Server, registry),
+		domain.Component(),
+		limiter.Component(),
+		broadcaster.Component(),
+		app.Server("graphql server", func(context.Context) (server.Contract, error) {
+			var subscriber gqlrouter.Subscriber
+
+			if broadcaster.Broadcaster != nil {
+				subscriber = broadcaster.Broadcaster
+			}
+
+			options := []gqlrouter.Option{gqlrouter.WithHealth(registry)}
+
+			// Limit the request rate of clients.
+			if limiter.Limiter != nil {
+				options = append(options, gqlrouter.WithRateLimiter(limiter.Limiter))
+			}
+
+			router, err := gqlrouter.New(config.Router, domain, subscriber, options...)
+			if err != nil {
+				return nil, fmt.Errorf("graphql router: %w", err)
+			}
+
+			return httpserver.New(config.HTTP, router.Handler()), nil
+		}, app.DomainName, app.RateLimitName, app.BroadcasterName),
+		app.Admin(config.Admin, telemetry, config, registry),
 	)
 
-	// Initialize user domain.
-	domain, err := domain.New(ctx, config.Postgres)
-	if err != nil {
-		logger.ErrorContext(ctx, "domain",
-			slog.String("new", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	defer func() {
-		if err := domain.Close(); err != nil {
-			logger.ErrorContext(ctx, "domain",
-				slog.String("close", err.Error()),
-			)
-		}
-	}()
-
-	// Create graphql router.
-	router, err := gqlrouter.New(config.Router, domain)
-	if err != nil {
-		logger.ErrorContext(ctx, "graphql router",
-			slog.String("new", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	// Create and run HTTP server.
-	server := httpserver.New(config.HTTP, router.Handler())
-	if err := server.Run(ctx); err != nil {
-		logger.ErrorContext(ctx, "graphql router",
-			slog.String("run", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	logger.InfoContext(ctx, "exiting")
-
-	return osx.ExitSuccess
+	return application.Run(context.Background())
 }
@@ -4,17 +4,29 @@ import (
 	"fmt"
 
 	"github.com/yolkhovyy/go-otelw/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
 	grpcrouter "github.com/yolkhovyy/go-userv/internal/router/grpc"
 	grpcserver "github.com/yolkhovyy/go-userv/internal/server/grpc"
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
 	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
 	"github.com/yolkhovyy/go-utilities/viperx"
 )
 
 type Config struct {
 	otelw.Config `yaml:",inline" mapstructure:",squash"`
-	GRPC         grpcserver.Config `yaml:"grpc" mapstructure:"GRPC"`
-	Router       grpcrouter.Config `yaml:"router" mapstructure:"Router"`
-	Postgres     postgres.Config   `yaml:"postgres" mapstructure:"Postgres"`
+	App          app.Config         `yaml:"app" mapstructure:"App"`
+	GRPC         grpcserver.Config  `yaml:"grpc" mapstructure:"GRPC"`
+	Connect      httpserver.Config  `yaml:"connect" mapstructure:"Connect"`
+	Router       grpcrouter.Config  `yaml:"router" mapstructure:"Router"`
+	Postgres     postgres.Config    `yaml:"postgres" mapstructure:"Postgres"`
+	Admin        admin.Config       `yaml:"admin" mapstructure:"Admin"`
+	Health       health.Config      `yaml:"health" mapstructure:"Health"`
+	RateLimit    ratelimit.Config   `yaml:"rateLimit" mapstructure:"RateLimit"`
+	Idempotency  idempotency.Config `yaml:"idempotency" mapstructure:"Idempotency"`
 }
 
 func NewConfig() *Config {
@@ -25,8 +37,16 @@ func (c *Config) Load(configFile string, prefix string) error {
 	vprx := viperx.New(configFile, prefix, nil)
 
 	vprx.SetDefaults(otelw.Defaults())
+	vprx.SetDefaults(app.Defaults())
 	vprx.SetDefaults(grpcserver.Defaults())
+	vprx.SetDefaults(connectDefaults())
+	vprx.SetDefaults(grpcrouter.Defaults())
 	vprx.SetDefaults(postgres.Defaults())
+	vprx.SetDefaults(health.Defaults())
+	vprx.SetDefaults(healthDefaults())
+	vprx.SetDefaults(admin.Defaults())
+	vprx.SetDefaults(ratelimit.Defaults())
+	vprx.SetDefaults(idempotency.Defaults())
 
 	if err := vprx.Load(c); err != nil {
 		return fmt.Errorf("load config: %w", err)
@@ -34,3 +54,23 @@ func (c *Config) Load(configFile string, prefix string) error {
 
 	return nil
 }
+
+func connectDefaults() map[string]any {
+	return map[string]any{
+		"Connect.Port":              defaultConnectPort,
+		"Connect.ShutdownTimeout":   httpserver.DefaultShutdownTimeout,
+		"Connect.ReadHeaderTimeout": httpserver.DefaultReadHeaderTimeout,
+		"Connect.IdleTimeout":       httpserver.DefaultIdleTimeout,
+		"Connect.MaxHeaderBytes":    httpserver.DefaultMaxHeaderBytes,
+	}
+}
+
+const defaultConnectPort = 8082
+
+// healthDefaults serves the health checks on their own port, the service
+// has no public HTTP port to serve them on.
+func healthDefaults() map[string]any {
+	return map[string]any{
+		"Health.Server.Enable": true,
+	}
+}
@@ -2,23 +2,20 @@ package main
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
-	"log/slog"
 	"os"
-	"os/signal"
-	"syscall"
 
 	"github.com/yolkhovyy/go-userv/cmd/user-grpc/version"
-	"github.com/yolkhovyy/go-userv/internal/domain"
-	"github.com/yolkhovyy/go-userv/internal/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-userv/internal/health"
 	grpcrouter "github.com/yolkhovyy/go-userv/internal/router/grpc"
 	grpcserver "github.com/yolkhovyy/go-userv/internal/server/grpc"
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
 	"github.com/yolkhovyy/go-utilities/buildinfo"
 	"github.com/yolkhovyy/go-utilities/osx"
-	"go.opentelemetry.io/otel/attribute"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 )
 
 const (
@@ -34,7 +31,7 @@ func main() {
 func run() int {
 	buildInfo := buildinfo.ReadData()
 
-	// Congig file.
+	// Config file.
 	configFile := flag.String("config", "config.yml",
 		"Path to the configuration file (default: config.yml)")
 
@@ -50,71 +47,59 @@ func run() int {
 		return osx.ExitConfigError
 	}
 
-	// The ctx.Done() channel will close when one of the signals arrives.
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
-	defer stop()
+	telemetry := app.NewTelemetry(config.Config, admin.BuildInfo{
+		Service:  serviceName,
+		Version:  version.Tag,
+		Revision: buildInfo.Revision,
+		Time:     buildInfo.Time,
+	})
 
-	// Telemetry.
-	serviceAttributes := []attribute.KeyValue{
-		semconv.ServiceNameKey.String(serviceName),
-		semconv.ServiceVersionKey.String(version.Tag),
-	}
-
-	logger, tracer, metric, err := otelw.Configure(ctx, config.Config, serviceAttributes)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "otelw configure: %v", err)
+	// Liveness and readiness checks, readiness fails once shutdown starts.
+	registry := health.New(config.Health)
 
-		return osx.ExitFailure
-	}
+	domain := app.NewDomain(config.Postgres, registry)
+	limiter := app.NewRateLimiter(config.RateLimit)
+	store := app.NewIdempotencyStore(config.Idempotency, config.Postgres)
 
-	defer func() {
-		err := errors.Join(err,
-			metric.Shutdown(ctx),
-			tracer.Shutdown(ctx),
-			logger.Shutdown(ctx))
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "otelw shutdown: %v", err)
-		}
-	}()
+	router := grpcrouter.New(config.Router, domain)
 
-	logger.InfoContext(ctx, "build info",
-		slog.String("version", version.Tag),
-		slog.String("time", buildInfo.Time),
-		slog.String("commit", buildInfo.Revision),
-	)
+	// interceptors are shared by the gRPC and Connect servers, created once the components started.
+	interceptors := func() grpcrouter.Interceptors {
+		var interceptors grpcrouter.Interceptors
 
-	// Initialize user domain.
-	domain, err := domain.New(ctx, config.Postgres)
-	if err != nil {
-		logger.ErrorContext(ctx, "domain",
-			slog.String("new", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
+		// Limit the request rate of clients, streams included.
+		if limiter.Limiter != nil {
+			interceptors.Unary = append(interceptors.Unary, grpcrouter.RateLimitInterceptor(limiter.Limiter))
+			interceptors.Stream = append(interceptors.Stream, grpcrouter.RateLimitStreamInterceptor(limiter.Limiter))
+		}
 
-	defer func() {
-		if err := domain.Close(); err != nil {
-			logger.ErrorContext(ctx, "domain",
-				slog.String("close", err.Error()),
-			)
+		// Replay responses of calls retried with an idempotency key.
+		if store.Store != nil {
+			interceptors.Unary = append(interceptors.Unary, grpcrouter.IdempotencyInterceptor(store.Store, limiter.Limiter))
 		}
-	}()
 
-	// Create grpc router.
-	router := grpcrouter.New(config.Router, domain)
+		return interceptors
+	}
 
-	// Create and run gRPC server.
-	server := grpcserver.New(config.GRPC, router, grpcrouter.Options()...)
-	if err := server.Run(ctx); err != nil {
-		logger.ErrorContext(ctx, "http server",
-			slog.String("run", err.Error()),
-		)
+	application := app.New(config.App)
+	application.Add(
+		telemetry.Component(),
+		app.Health(config.Health.Server, registry),
+		domain.Component(),
+		limiter.Component(),
+		store.Component(),
+		app.Server("grpc server", func(context.Context) (server.Contract, error) {
+			return grpcserver.New(config.GRPC, router, grpcrouter.Options(interceptors())...), nil
+		}, app.DomainName, app.RateLimitName, app.IdempotencyName),
+		app.Admin(config.Admin, telemetry, config, registry),
+	)
 
-		return osx.ExitFailure
+	// Connect and gRPC-Web calls are served on a separate port.
+	if config.Router.Connect {
+		application.Add(app.Server("connect server", func(context.Context) (server.Contract, error) {
+			return httpserver.New(config.Connect, router.ConnectHandler(interceptors())), nil
+		}, app.DomainName, app.RateLimitName, app.IdempotencyName))
 	}
 
-	logger.InfoContext(ctx, "exiting")
-
-	return osx.ExitSuccess
+	return application.Run(context.Background())
 }
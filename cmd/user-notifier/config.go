@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/yolkhovyy/go-otelw/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/health"
 	"github.com/yolkhovyy/go-userv/internal/notifier"
 	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
 	"github.com/yolkhovyy/go-utilities/viperx"
@@ -11,8 +14,11 @@ import (
 
 type Config struct {
 	otelw.Config `yaml:",inline" mapstructure:",squash"`
+	App          app.Config      `yaml:"app" mapstructure:"App"`
 	Kafka        notifier.Config `yaml:"kafka" mapstructure:"Kafka"`
 	Postgres     postgres.Config `yaml:"postgres" mapstructure:"Postgres"`
+	Admin        admin.Config    `yaml:"admin" mapstructure:"Admin"`
+	Health       health.Config   `yaml:"health" mapstructure:"Health"`
 }
 
 func NewConfig() *Config {
@@ -23,8 +29,12 @@ func (c *Config) Load(configFile string, prefix string) error {
 	vprx := viperx.New(configFile, prefix, nil)
 
 	vprx.SetDefaults(otelw.Defaults())
+	vprx.SetDefaults(app.Defaults())
 	vprx.SetDefaults(notifier.Defaults())
 	vprx.SetDefaults(postgres.Defaults())
+	vprx.SetDefaults(health.Defaults())
+	vprx.SetDefaults(healthDefaults())
+	vprx.SetDefaults(admin.Defaults())
 
 	if err := vprx.Load(c); err != nil {
 		return fmt.Errorf("load config: %w", err)
@@ -32,3 +42,11 @@ func (c *Config) Load(configFile string, prefix string) error {
 
 	return nil
 }
+
+// healthDefaults serves the health checks on their own port, the service
+// has no public HTTP port to serve them on.
+func healthDefaults() map[string]any {
+	return map[string]any{
+		"Health.Server.Enable": true,
+	}
+}
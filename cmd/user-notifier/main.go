@@ -2,21 +2,18 @@ package main
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
-	"log/slog"
 	"os"
-	"os/signal"
-	"syscall"
 
 	"github.com/yolkhovyy/go-userv/cmd/user-notifier/version"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-userv/internal/health"
 	"github.com/yolkhovyy/go-userv/internal/notifier"
-	"github.com/yolkhovyy/go-userv/internal/otelw"
 	"github.com/yolkhovyy/go-utilities/buildinfo"
 	"github.com/yolkhovyy/go-utilities/osx"
-	"go.opentelemetry.io/otel/attribute"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 )
 
 const (
@@ -28,11 +25,10 @@ func main() {
 	os.Exit(run())
 }
 
-//nolint:funlen
 func run() int {
 	buildInfo := buildinfo.ReadData()
 
-	// Congig file.
+	// Config file.
 	configFile := flag.String("config", "config.yml",
 		"Path to the configuration file (default: config.yml)")
 
@@ -48,60 +44,27 @@ func run() int {
 		return osx.ExitConfigError
 	}
 
-	// Context, Done channel will close when one of the listed signals arrives.
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
-	defer stop()
-
-	// Telemetry.
-	serviceAttributes := []attribute.KeyValue{
-		semconv.ServiceNameKey.String(serviceName),
-		semconv.ServiceVersionKey.String(version.Tag),
-	}
-
-	logger, tracer, metric, err := otelw.Configure(ctx, config.Config, serviceAttributes)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "otelw configure: %v", err)
-
-		return osx.ExitFailure
-	}
-
-	defer func() {
-		err := errors.Join(err,
-			metric.Shutdown(ctx),
-			tracer.Shutdown(ctx),
-			logger.Shutdown(ctx))
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "otelw shutdown: %v", err)
-		}
-	}()
-
-	logger.InfoContext(ctx, "build info",
-		slog.String("version", version.Tag),
-		slog.String("time", buildInfo.Time),
-		slog.String("commit", buildInfo.Revision),
+	telemetry := app.NewTelemetry(config.Config, admin.BuildInfo{
+		Service:  serviceName,
+		Version:  version.Tag,
+		Revision: buildInfo.Revision,
+		Time:     buildInfo.Time,
+	})
+
+	// Liveness and readiness checks, readiness fails once shutdown starts.
+	registry := health.New(config.Health)
+	registry.AddReadiness("kafka", notifier.KafkaChecker(config.Kafka))
+
+	// Listen for user changes and notify consumers, serve health checks on the health server.
+	application := app.New(config.App)
+	application.Add(
+		telemetry.Component(),
+		app.Health(config.Health.Server, registry),
+		app.Server("notifier", func(context.Context) (server.Contract, error) {
+			return notifier.New(config.Postgres, config.Kafka)
+		}, app.TelemetryName),
+		app.Admin(config.Admin, telemetry, config, registry),
 	)
 
-	// Initialize notifier.
-	notifier, err := notifier.New(config.Postgres, config.Kafka)
-	if err != nil {
-		logger.ErrorContext(ctx, "notifier",
-			slog.String("new", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	// Listening for user changes and notify consumers.
-	err = notifier.Run(ctx)
-	if err != nil {
-		logger.ErrorContext(ctx, "notifier",
-			slog.String("run", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	logger.InfoContext(ctx, "exiting")
-
-	return osx.ExitSuccess
+	return application.Run(context.Background())
 }
@@ -2,24 +2,22 @@ package main
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
-	"log/slog"
 	"os"
-	"os/signal"
-	"syscall"
 
+	"github.com/gin-gonic/gin"
 	"github.com/yolkhovyy/go-userv/cmd/user-rest/version"
-	"github.com/yolkhovyy/go-userv/internal/domain"
-	"github.com/yolkhovyy/go-userv/internal/otelw"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	"github.com/yolkhovyy/go-userv/internal/app"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
 	ginrouter "github.com/yolkhovyy/go-userv/internal/router/gin"
 	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
 	"github.com/yolkhovyy/go-utilities/buildinfo"
 	"github.com/yolkhovyy/go-utilities/osx"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
-	"go.opentelemetry.io/otel/attribute"
-	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 )
 
 const (
@@ -51,71 +49,55 @@ func run() int {
 		return osx.ExitConfigError
 	}
 
-	// The ctx.Done() channel will close when one of the signals arrives.
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
-	defer stop()
-
-	// Telemetry.
-	serviceAttributes := []attribute.KeyValue{
-		semconv.ServiceNameKey.String(serviceName),
-		semconv.ServiceVersionKey.String(version.Tag),
-	}
-
-	logger, tracer, metric, err := otelw.Configure(ctx, config.Config, serviceAttributes)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "otelw configure: %v", err)
-
-		return osx.ExitFailure
-	}
-
-	defer func() {
-		err := errors.Join(err,
-			metric.Shutdown(ctx),
-			tracer.Shutdown(ctx),
-			logger.Shutdown(ctx))
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "otelw shutdown: %v", err)
-		}
-	}()
-
-	logger.InfoContext(ctx, "build info",
-		slog.String("version", version.Tag),
-		slog.String("time", buildInfo.Time),
-		slog.String("commit", buildInfo.Revision),
+	telemetry := app.NewTelemetry(config.Config, admin.BuildInfo{
+		Service:  serviceName,
+		Version:  version.Tag,
+		Revision: buildInfo.Revision,
+		Time:     buildInfo.Time,
+	})
+
+	// Liveness and readiness checks, readiness fails once shutdown starts.
+	registry := health.New(config.Health)
+
+	domain := app.NewDomain(config.Postgres, registry)
+	limiter := app.NewRateLimiter(config.RateLimit)
+	store := app.NewIdempotencyStore(config.Idempotency, config.Postgres)
+
+	application := app.New(config.App)
+	application.Add(
+		telemetry.Component(),
+		app.Health(config.Health.Server, registry),
+		domain.Component(),
+		limiter.Component(),
+		store.Component(),
+		app.Server("http server", func(context.Context) (server.Contract, error) {
+			handlers := []gin.HandlerFunc{otelgin.Middleware(serviceName)}
+
+			// Clients behind trusted proxies are identified by X-Forwarded-For.
+			proxies, err := ratelimit.ParseProxies(config.Router.TrustedProxies)
+			if err != nil {
+				return nil, fmt.Errorf("trusted proxies: %w", err)
+			}
+
+			// Limit the request rate of clients.
+			if limiter.Limiter != nil {
+				handlers = append(handlers, ginrouter.RateLimit(limiter.Limiter, proxies))
+			}
+
+			// Replay responses of requests retried with an idempotency key.
+			if store.Store != nil {
+				handlers = append(handlers, ginrouter.Idempotency(store.Store, limiter.Limiter, proxies))
+			}
+
+			router, err := ginrouter.New(config.Router, domain, registry, handlers...)
+			if err != nil {
+				return nil, fmt.Errorf("rest router: %w", err)
+			}
+
+			return httpserver.New(config.HTTP, router.Handler()), nil
+		}, app.DomainName, app.RateLimitName, app.IdempotencyName),
+		app.Admin(config.Admin, telemetry, config, registry),
 	)
 
-	// Initialize user domain.
-	domain, err := domain.New(ctx, config.Postgres)
-	if err != nil {
-		logger.ErrorContext(ctx, "domain",
-			slog.String("new", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	defer func() {
-		if err := domain.Close(); err != nil {
-			logger.ErrorContext(ctx, "domain",
-				slog.String("close", err.Error()),
-			)
-		}
-	}()
-
-	// Create gin router.
-	router := ginrouter.New(config.Router, domain, otelgin.Middleware(serviceName))
-
-	// Create and run HTTP server.
-	server := httpserver.New(config.HTTP, router.Handler())
-	if err := server.Run(ctx); err != nil {
-		logger.ErrorContext(ctx, "http server",
-			slog.String("run", err.Error()),
-		)
-
-		return osx.ExitFailure
-	}
-
-	logger.InfoContext(ctx, "exiting")
-
-	return osx.ExitSuccess
+	return application.Run(context.Background())
 }
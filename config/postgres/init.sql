@@ -10,6 +10,8 @@ CREATE TABLE users (
     updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 
+-- The request id is set with set_config('app.request_id', ..., true)
+-- by the transaction changing the user, it is NULL otherwise.
 CREATE OR REPLACE FUNCTION notify_user_changes()
 RETURNS TRIGGER AS $$
 BEGIN
@@ -25,7 +27,8 @@ BEGIN
                 'email', OLD.email,
                 'country', OLD.country,
                 'createdAt', OLD.created_at,
-                'updatedAt', OLD.updated_at
+                'updatedAt', OLD.updated_at,
+                'requestId', NULLIF(current_setting('app.request_id', true), '')
             )::text
         );
     ELSE
@@ -40,7 +43,8 @@ BEGIN
                 'email', NEW.email,
                 'country', NEW.country,
                 'createdAt', NEW.created_at,
-                'updatedAt', NEW.updated_at
+                'updatedAt', NEW.updated_at,
+                'requestId', NULLIF(current_setting('app.request_id', true), '')
             )::text
         );
     END IF;
@@ -51,3 +55,16 @@ $$ LANGUAGE plpgsql;
 CREATE TRIGGER user_changes_trigger
 AFTER INSERT OR UPDATE OR DELETE ON users
 FOR EACH ROW EXECUTE FUNCTION notify_user_changes();
+
+CREATE TABLE idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    fingerprint CHAR(64) NOT NULL,
+    -- NULL until the request completes.
+    status_code INTEGER,
+    content_type TEXT,
+    body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -9,4 +9,7 @@ var (
 	ErrCountryCode = errors.New("country code")
 	ErrEmail       = errors.New("email")
 	ErrPassword    = errors.New("password")
+	ErrID          = errors.New("id")
+	ErrPage        = errors.New("page")
+	ErrLimit       = errors.New("limit")
 )
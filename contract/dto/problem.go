@@ -0,0 +1,80 @@
+package dto
+
+import "strings"
+
+// ProblemContentType is the media type of problem details responses.
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypeBase prefixes the problem type URIs, the codes are documented there.
+const ProblemTypeBase = "https://github.com/yolkhovyy/go-userv/blob/main/contract/openapi/PROBLEMS.md#"
+
+// Stable problem codes.
+const (
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeMalformedRequest = "MALFORMED_REQUEST"
+	CodeUserNotFound     = "USER_NOT_FOUND"
+	CodeUserConflict     = "USER_CONFLICT"
+	CodeRouteNotFound    = "ROUTE_NOT_FOUND"
+	CodeInternalError    = "INTERNAL_ERROR"
+	CodeRateLimited      = "RATE_LIMITED"
+	CodeRequestTooLarge  = "REQUEST_TOO_LARGE"
+	CodeRequestTimeout   = "REQUEST_TIMEOUT"
+
+	CodeIdempotencyKeyReused     = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotencyKeyInProgress = "IDEMPOTENCY_KEY_IN_PROGRESS"
+)
+
+// Problem is an RFC 9457 problem details object.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"`
+	Code      string       `json:"code"`
+	RequestID string       `json:"requestId,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError is the validation failure of a single request field.
+type FieldError struct {
+	Field  string `json:"field"`
+	Detail string `json:"detail"`
+	err    error
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Detail
+}
+
+func (e FieldError) Unwrap() error {
+	return e.err
+}
+
+// ValidationError lists the invalid fields of a request.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Error()
+	}
+
+	return "invalid: " + strings.Join(messages, ", ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Fields))
+	for i, field := range e.Fields {
+		errs[i] = field
+	}
+
+	return errs
+}
+
+// NewFieldError returns a validation error of a single field.
+func NewFieldError(field, detail string, err error) *ValidationError {
+	return &ValidationError{Fields: []FieldError{{Field: field, Detail: detail, err: err}}}
+}
@@ -5,58 +5,80 @@ import (
 	"regexp"
 )
 
+// Details of the field validation errors.
+const (
+	DetailRequired    = "must not be empty"
+	DetailEmail       = "must be a valid email address"
+	DetailCountryCode = "must be an ISO 3166-1 alpha-2 country code"
+)
+
+// Validate returns a *ValidationError listing all invalid fields.
 func (u *UserInput) Validate() error {
+	var fields []FieldError
+
 	if u.FirstName == "" {
-		return fmt.Errorf("invalid: %w %s", ErrFirstName, u.FirstName)
+		fields = append(fields, FieldError{Field: "firstName", Detail: DetailRequired, err: ErrFirstName})
 	}
 
 	if u.LastName == "" {
-		return fmt.Errorf("invalid: %w %s", ErrLastName, u.LastName)
+		fields = append(fields, FieldError{Field: "lastName", Detail: DetailRequired, err: ErrLastName})
 	}
 
 	if u.Nickname == "" {
-		return fmt.Errorf("invalid: %w", ErrNickname)
+		fields = append(fields, FieldError{Field: "nickname", Detail: DetailRequired, err: ErrNickname})
 	}
 
 	if err := ValidateEmail(u.Email); err != nil {
-		return fmt.Errorf("invalid: %w", err)
+		fields = append(fields, FieldError{Field: "email", Detail: DetailEmail, err: err})
 	}
 
 	if err := ValidateCountryCode(u.Country); err != nil {
-		return fmt.Errorf("invalid: %w", err)
+		fields = append(fields, FieldError{Field: "country", Detail: DetailCountryCode, err: err})
 	}
 
 	if err := ValidatePassword(u.Password); err != nil {
-		return fmt.Errorf("invalid: %w", err)
+		fields = append(fields, FieldError{Field: "password", Detail: err.Error(), err: err})
 	}
 
-	return nil
+	return validationError(fields)
 }
 
+// Validate returns a *ValidationError listing all invalid fields, empty fields are not validated.
 func (u *UserUpdate) Validate() error {
+	var fields []FieldError
+
 	if u.Email != "" {
 		if err := ValidateEmail(u.Email); err != nil {
-			return fmt.Errorf("invalid: %w", err)
+			fields = append(fields, FieldError{Field: "email", Detail: DetailEmail, err: err})
 		}
 	}
 
 	if u.Country != "" {
 		if err := ValidateCountryCode(u.Country); err != nil {
-			return fmt.Errorf("invalid: %w", err)
+			fields = append(fields, FieldError{Field: "country", Detail: DetailCountryCode, err: err})
 		}
 	}
 
 	if u.Password != "" {
 		if err := ValidatePassword(u.Password); err != nil {
-			return fmt.Errorf("invalid: %w", err)
+			fields = append(fields, FieldError{Field: "password", Detail: err.Error(), err: err})
 		}
 	}
 
-	return nil
+	return validationError(fields)
+}
+
+func validationError(fields []FieldError) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Fields: fields}
 }
 
+// ValidateCountryCode accepts assigned ISO 3166-1 alpha-2 codes, e.g. GB, and empty codes.
 func ValidateCountryCode(countryCode string) error {
-	if match, _ := regexp.MatchString("^[A-Z]{2}$", countryCode); !match && countryCode != "" {
+	if _, ok := countryCodes[countryCode]; !ok && countryCode != "" {
 		return fmt.Errorf("country code: %w %s", ErrCountryCode, countryCode)
 	}
 
@@ -0,0 +1,10 @@
+// Package graphql holds the GraphQL contract, the executable schema is checked against it.
+package graphql
+
+import _ "embed"
+
+// Schema is the SDL of the GraphQL API.
+//
+//nolint:gochecknoglobals
+//go:embed schema.graphql
+var Schema string
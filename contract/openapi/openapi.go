@@ -0,0 +1,10 @@
+// Package openapi holds the OpenAPI contract of the REST API.
+package openapi
+
+import _ "embed"
+
+// Spec is the OpenAPI document of the REST API.
+//
+//nolint:gochecknoglobals
+//go:embed openapi.json
+var Spec []byte
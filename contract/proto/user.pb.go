@@ -7,6 +7,7 @@
 package proto
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -463,6 +464,50 @@ func (x *ListRequest) GetCountry() string {
 	return ""
 }
 
+type ExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_contract_proto_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contract_proto_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_contract_proto_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
 var File_contract_proto_user_proto protoreflect.FileDescriptor
 
 var file_contract_proto_user_proto_rawDesc = string([]byte{
@@ -471,77 +516,489 @@ var file_contract_proto_user_proto_rawDesc = string([]byte{
 	0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x04, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x22, 0xaf, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0xc0, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x67, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x22, 0x51,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x32, 0xd5, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0a, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x1a, 0x0a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x1f, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x26, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x12, 0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x16, 0x5a, 0x14, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x94, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x83, 0x0e, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x10, 0x01, 0x18, 0x64, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x26, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x10, 0x01, 0x18, 0x64, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x72, 0x05, 0x18, 0xff, 0x01, 0x60, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0xbe, 0x0c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0xa3, 0x0c, 0xba, 0x48, 0x9f, 0x0c, 0xba, 0x01, 0x9b, 0x0c, 0x0a, 0x0c, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x6d, 0x75, 0x73,
+	0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x49, 0x53, 0x4f, 0x20, 0x33, 0x31, 0x36, 0x36,
+	0x2d, 0x31, 0x20, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x32, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0xde, 0x0b, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69,
+	0x6e, 0x20, 0x5b, 0x27, 0x41, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x45, 0x27, 0x2c, 0x20, 0x27,
+	0x41, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x49, 0x27, 0x2c,
+	0x20, 0x27, 0x41, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4f,
+	0x27, 0x2c, 0x20, 0x27, 0x41, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x52, 0x27, 0x2c, 0x20, 0x27,
+	0x41, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x55, 0x27, 0x2c,
+	0x20, 0x27, 0x41, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x5a,
+	0x27, 0x2c, 0x20, 0x27, 0x42, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x42, 0x27, 0x2c, 0x20, 0x27,
+	0x42, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x46, 0x27, 0x2c,
+	0x20, 0x27, 0x42, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x49,
+	0x27, 0x2c, 0x20, 0x27, 0x42, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4c, 0x27, 0x2c, 0x20, 0x27,
+	0x42, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4f, 0x27, 0x2c,
+	0x20, 0x27, 0x42, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x53,
+	0x27, 0x2c, 0x20, 0x27, 0x42, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x56, 0x27, 0x2c, 0x20, 0x27,
+	0x42, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x5a, 0x27, 0x2c,
+	0x20, 0x27, 0x43, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x44,
+	0x27, 0x2c, 0x20, 0x27, 0x43, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x47, 0x27, 0x2c, 0x20, 0x27,
+	0x43, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4b, 0x27, 0x2c,
+	0x20, 0x27, 0x43, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4e,
+	0x27, 0x2c, 0x20, 0x27, 0x43, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x52, 0x27, 0x2c, 0x20, 0x27,
+	0x43, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x57, 0x27, 0x2c,
+	0x20, 0x27, 0x43, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x5a,
+	0x27, 0x2c, 0x20, 0x27, 0x44, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4a, 0x27, 0x2c, 0x20, 0x27,
+	0x44, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4f, 0x27, 0x2c,
+	0x20, 0x27, 0x44, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x45,
+	0x27, 0x2c, 0x20, 0x27, 0x45, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x48, 0x27, 0x2c, 0x20, 0x27,
+	0x45, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x54, 0x27, 0x2c,
+	0x20, 0x27, 0x46, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4b,
+	0x27, 0x2c, 0x20, 0x27, 0x46, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4f, 0x27, 0x2c, 0x20, 0x27,
+	0x46, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x42, 0x27, 0x2c,
+	0x20, 0x27, 0x47, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x46,
+	0x27, 0x2c, 0x20, 0x27, 0x47, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x48, 0x27, 0x2c, 0x20, 0x27,
+	0x47, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4d, 0x27, 0x2c,
+	0x20, 0x27, 0x47, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x51,
+	0x27, 0x2c, 0x20, 0x27, 0x47, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x53, 0x27, 0x2c, 0x20, 0x27,
+	0x47, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x57, 0x27, 0x2c,
+	0x20, 0x27, 0x47, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4d,
+	0x27, 0x2c, 0x20, 0x27, 0x48, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x52, 0x27, 0x2c, 0x20, 0x27,
+	0x48, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x44, 0x27, 0x2c,
+	0x20, 0x27, 0x49, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4d,
+	0x27, 0x2c, 0x20, 0x27, 0x49, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4f, 0x27, 0x2c, 0x20, 0x27,
+	0x49, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x53, 0x27, 0x2c,
+	0x20, 0x27, 0x49, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x4d,
+	0x27, 0x2c, 0x20, 0x27, 0x4a, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x50, 0x27, 0x2c, 0x20, 0x27,
+	0x4b, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x48, 0x27, 0x2c,
+	0x20, 0x27, 0x4b, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x4e,
+	0x27, 0x2c, 0x20, 0x27, 0x4b, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x52, 0x27, 0x2c, 0x20, 0x27,
+	0x4b, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x5a, 0x27, 0x2c,
+	0x20, 0x27, 0x4c, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x43,
+	0x27, 0x2c, 0x20, 0x27, 0x4c, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4b, 0x27, 0x2c, 0x20, 0x27,
+	0x4c, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x54, 0x27, 0x2c,
+	0x20, 0x27, 0x4c, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x59,
+	0x27, 0x2c, 0x20, 0x27, 0x4d, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x43, 0x27, 0x2c, 0x20, 0x27,
+	0x4d, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x46, 0x27, 0x2c,
+	0x20, 0x27, 0x4d, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4b,
+	0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4d, 0x27, 0x2c, 0x20, 0x27,
+	0x4d, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x50, 0x27, 0x2c,
+	0x20, 0x27, 0x4d, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x53,
+	0x27, 0x2c, 0x20, 0x27, 0x4d, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x55, 0x27, 0x2c, 0x20, 0x27,
+	0x4d, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x58, 0x27, 0x2c,
+	0x20, 0x27, 0x4d, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x41,
+	0x27, 0x2c, 0x20, 0x27, 0x4e, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x45, 0x27, 0x2c, 0x20, 0x27,
+	0x4e, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x49, 0x27, 0x2c,
+	0x20, 0x27, 0x4e, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x50,
+	0x27, 0x2c, 0x20, 0x27, 0x4e, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x55, 0x27, 0x2c, 0x20, 0x27,
+	0x4e, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4f, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x41, 0x27, 0x2c,
+	0x20, 0x27, 0x50, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x47,
+	0x27, 0x2c, 0x20, 0x27, 0x50, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4b, 0x27, 0x2c, 0x20, 0x27,
+	0x50, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4e, 0x27, 0x2c,
+	0x20, 0x27, 0x50, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x54,
+	0x27, 0x2c, 0x20, 0x27, 0x50, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x59, 0x27, 0x2c, 0x20, 0x27,
+	0x51, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x4f, 0x27, 0x2c,
+	0x20, 0x27, 0x52, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x57,
+	0x27, 0x2c, 0x20, 0x27, 0x53, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x42, 0x27, 0x2c, 0x20, 0x27,
+	0x53, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x45, 0x27, 0x2c,
+	0x20, 0x27, 0x53, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x49,
+	0x27, 0x2c, 0x20, 0x27, 0x53, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4b, 0x27, 0x2c, 0x20, 0x27,
+	0x53, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4e, 0x27, 0x2c,
+	0x20, 0x27, 0x53, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x53,
+	0x27, 0x2c, 0x20, 0x27, 0x53, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x56, 0x27, 0x2c, 0x20, 0x27,
+	0x53, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x5a, 0x27, 0x2c,
+	0x20, 0x27, 0x54, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x46,
+	0x27, 0x2c, 0x20, 0x27, 0x54, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x48, 0x27, 0x2c, 0x20, 0x27,
+	0x54, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4c, 0x27, 0x2c,
+	0x20, 0x27, 0x54, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4f,
+	0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x54, 0x27, 0x2c, 0x20, 0x27,
+	0x54, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x5a, 0x27, 0x2c,
+	0x20, 0x27, 0x55, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x4d,
+	0x27, 0x2c, 0x20, 0x27, 0x55, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x59, 0x27, 0x2c, 0x20, 0x27,
+	0x55, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x43, 0x27, 0x2c,
+	0x20, 0x27, 0x56, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x49,
+	0x27, 0x2c, 0x20, 0x27, 0x56, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x55, 0x27, 0x2c, 0x20, 0x27,
+	0x57, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x57, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x59, 0x45, 0x27, 0x2c,
+	0x20, 0x27, 0x59, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x4d,
+	0x27, 0x2c, 0x20, 0x27, 0x5a, 0x57, 0x27, 0x5d, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xa7, 0x0e,
+	0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xd8,
+	0x01, 0x01, 0x72, 0x02, 0x18, 0x64, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xd8, 0x01, 0x01, 0x72, 0x02, 0x18, 0x64,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xd8, 0x01, 0x01, 0x72, 0x02, 0x18, 0x64, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xd8, 0x01, 0x01, 0x72, 0x05, 0x18, 0xff, 0x01, 0x60, 0x01,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0xc1, 0x0c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0xa6, 0x0c, 0xba, 0x48, 0xa2, 0x0c,
+	0xba, 0x01, 0x9b, 0x0c, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x2a, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x49,
+	0x53, 0x4f, 0x20, 0x33, 0x31, 0x36, 0x36, 0x2d, 0x31, 0x20, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d,
+	0x32, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0xde,
+	0x0b, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x41, 0x44, 0x27, 0x2c, 0x20,
+	0x27, 0x41, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x47, 0x27,
+	0x2c, 0x20, 0x27, 0x41, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x41,
+	0x4d, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x51, 0x27, 0x2c, 0x20,
+	0x27, 0x41, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x54, 0x27,
+	0x2c, 0x20, 0x27, 0x41, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x41,
+	0x58, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x41, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x45, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4a, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4e, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x54, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x59, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x43, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x46, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x49, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x4d, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4f, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x56, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x59, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x45, 0x27, 0x2c, 0x20,
+	0x27, 0x44, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x44, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x45,
+	0x43, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x47, 0x27, 0x2c, 0x20,
+	0x27, 0x45, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x45, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x46,
+	0x4a, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4d, 0x27, 0x2c, 0x20,
+	0x27, 0x46, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x41, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x47,
+	0x45, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x47, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4c, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x47,
+	0x50, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x52, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x55, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x48,
+	0x4b, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4e, 0x27, 0x2c, 0x20,
+	0x27, 0x48, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x55, 0x27,
+	0x2c, 0x20, 0x27, 0x49, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x49,
+	0x4c, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4e, 0x27, 0x2c, 0x20,
+	0x27, 0x49, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x52, 0x27,
+	0x2c, 0x20, 0x27, 0x49, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4a,
+	0x45, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x4f, 0x27, 0x2c, 0x20,
+	0x27, 0x4a, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x47, 0x27,
+	0x2c, 0x20, 0x27, 0x4b, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4b,
+	0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x50, 0x27, 0x2c, 0x20,
+	0x27, 0x4b, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x59, 0x27,
+	0x2c, 0x20, 0x27, 0x4b, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4c,
+	0x42, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x49, 0x27, 0x2c, 0x20,
+	0x27, 0x4c, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x4c, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4c,
+	0x56, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x41, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4c, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4f, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x54, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x57, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x43, 0x27, 0x2c, 0x20,
+	0x27, 0x4e, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x47, 0x27,
+	0x2c, 0x20, 0x27, 0x4e, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4e,
+	0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x52, 0x27, 0x2c, 0x20,
+	0x27, 0x4e, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4f, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x50, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x50,
+	0x46, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x48, 0x27, 0x2c, 0x20,
+	0x27, 0x50, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x50, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x50,
+	0x53, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x57, 0x27, 0x2c, 0x20,
+	0x27, 0x50, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x51, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x45, 0x27,
+	0x2c, 0x20, 0x27, 0x52, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x52,
+	0x55, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x41, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x44, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4a, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x54, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x59, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x54,
+	0x44, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x47, 0x27, 0x2c, 0x20,
+	0x27, 0x54, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4b, 0x27,
+	0x2c, 0x20, 0x27, 0x54, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x54,
+	0x4e, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x27, 0x2c, 0x20,
+	0x27, 0x54, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x57, 0x27,
+	0x2c, 0x20, 0x27, 0x54, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x55,
+	0x47, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x55, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x41, 0x27,
+	0x2c, 0x20, 0x27, 0x56, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x56,
+	0x47, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x4e, 0x27, 0x2c, 0x20,
+	0x27, 0x56, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x57, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x57, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x59, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x59, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x5a,
+	0x41, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x57, 0x27, 0x5d, 0xd8,
+	0x01, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x67, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x22, 0x90, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x22, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0xc1, 0x0c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0xa6, 0x0c, 0xba, 0x48, 0xa2, 0x0c, 0xba, 0x01, 0x9b, 0x0c, 0x0a, 0x0c, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x6d, 0x75, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x49, 0x53, 0x4f, 0x20, 0x33, 0x31, 0x36, 0x36, 0x2d,
+	0x31, 0x20, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x32, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0xde, 0x0b, 0x74, 0x68, 0x69, 0x73, 0x20, 0x69, 0x6e,
+	0x20, 0x5b, 0x27, 0x41, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x41,
+	0x46, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x49, 0x27, 0x2c, 0x20,
+	0x27, 0x41, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4f, 0x27,
+	0x2c, 0x20, 0x27, 0x41, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x41,
+	0x53, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x55, 0x27, 0x2c, 0x20,
+	0x27, 0x41, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x5a, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x44, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x46, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x49, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x4d, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4f, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x57, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x5a, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x44, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4b, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4e, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x55, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x57, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x5a, 0x27,
+	0x2c, 0x20, 0x27, 0x44, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x44,
+	0x4b, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4f, 0x27, 0x2c, 0x20,
+	0x27, 0x44, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x45, 0x27,
+	0x2c, 0x20, 0x27, 0x45, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x45,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x54, 0x27, 0x2c, 0x20,
+	0x27, 0x46, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4b, 0x27,
+	0x2c, 0x20, 0x27, 0x46, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x46,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x42, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x46, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x47,
+	0x49, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4d, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x51, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x47,
+	0x54, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x57, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x48, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x48,
+	0x54, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x44, 0x27, 0x2c, 0x20,
+	0x27, 0x49, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x49, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x49,
+	0x51, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x49, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x4a, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4b,
+	0x45, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x48, 0x27, 0x2c, 0x20,
+	0x27, 0x4b, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x4e, 0x27,
+	0x2c, 0x20, 0x27, 0x4b, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4b,
+	0x57, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x5a, 0x27, 0x2c, 0x20,
+	0x27, 0x4c, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x43, 0x27,
+	0x2c, 0x20, 0x27, 0x4c, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x4c,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x54, 0x27, 0x2c, 0x20,
+	0x27, 0x4c, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x59, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x44, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x46, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4b, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x4e, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x50, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x56, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x58, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x41, 0x27,
+	0x2c, 0x20, 0x27, 0x4e, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4e,
+	0x46, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x49, 0x27, 0x2c, 0x20,
+	0x27, 0x4e, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x50, 0x27,
+	0x2c, 0x20, 0x27, 0x4e, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4e,
+	0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4f, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x41, 0x27, 0x2c, 0x20,
+	0x27, 0x50, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x47, 0x27,
+	0x2c, 0x20, 0x27, 0x50, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x50,
+	0x4c, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4e, 0x27, 0x2c, 0x20,
+	0x27, 0x50, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x54, 0x27,
+	0x2c, 0x20, 0x27, 0x50, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x51,
+	0x41, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x4f, 0x27, 0x2c, 0x20,
+	0x27, 0x52, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x57, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x43, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x45, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x49, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x4c, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4e, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x58, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x5a, 0x27, 0x2c, 0x20,
+	0x27, 0x54, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x46, 0x27,
+	0x2c, 0x20, 0x27, 0x54, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x54,
+	0x4a, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4c, 0x27, 0x2c, 0x20,
+	0x27, 0x54, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4f, 0x27,
+	0x2c, 0x20, 0x27, 0x54, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x54,
+	0x56, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x5a, 0x27, 0x2c, 0x20,
+	0x27, 0x55, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x55, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x55,
+	0x5a, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x43, 0x27, 0x2c, 0x20,
+	0x27, 0x56, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x49, 0x27,
+	0x2c, 0x20, 0x27, 0x56, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x57,
+	0x46, 0x27, 0x2c, 0x20, 0x27, 0x57, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x59, 0x45, 0x27, 0x2c, 0x20,
+	0x27, 0x59, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x5a, 0x57, 0x27, 0x5d, 0xd8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x22, 0xd3, 0x0c, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0xc1, 0x0c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0xa6, 0x0c, 0xba, 0x48, 0xa2, 0x0c, 0xba, 0x01,
+	0x9b, 0x0c, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x2a, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x49, 0x53, 0x4f,
+	0x20, 0x33, 0x31, 0x36, 0x36, 0x2d, 0x31, 0x20, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x2d, 0x32, 0x20,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0xde, 0x0b, 0x74,
+	0x68, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x27, 0x41, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x41,
+	0x45, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x47, 0x27, 0x2c, 0x20,
+	0x27, 0x41, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x41, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x41,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x54, 0x27, 0x2c, 0x20,
+	0x27, 0x41, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x41, 0x58, 0x27,
+	0x2c, 0x20, 0x27, 0x41, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x42, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x45, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x48, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x4c, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x4e, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x52, 0x27,
+	0x2c, 0x20, 0x27, 0x42, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x42,
+	0x56, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x42, 0x59, 0x27, 0x2c, 0x20,
+	0x27, 0x42, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x43, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x47, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x49, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x43,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x56, 0x27, 0x2c, 0x20,
+	0x27, 0x43, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x43, 0x59, 0x27,
+	0x2c, 0x20, 0x27, 0x43, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x44,
+	0x4a, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x4d, 0x27, 0x2c, 0x20,
+	0x27, 0x44, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x44, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x43, 0x27,
+	0x2c, 0x20, 0x27, 0x45, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x45,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x45, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x45, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4a, 0x27,
+	0x2c, 0x20, 0x27, 0x46, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x46,
+	0x4f, 0x27, 0x2c, 0x20, 0x27, 0x46, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x41, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x42, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x45, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x47,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4c, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x50, 0x27,
+	0x2c, 0x20, 0x27, 0x47, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x47,
+	0x53, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x55, 0x27, 0x2c, 0x20,
+	0x27, 0x47, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x47, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4b, 0x27,
+	0x2c, 0x20, 0x27, 0x48, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x48,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x48, 0x55, 0x27, 0x2c, 0x20,
+	0x27, 0x49, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4c, 0x27,
+	0x2c, 0x20, 0x27, 0x49, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x49,
+	0x4f, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x52, 0x27, 0x2c, 0x20,
+	0x27, 0x49, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x49, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x45, 0x27,
+	0x2c, 0x20, 0x27, 0x4a, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4a, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x4a,
+	0x50, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x47, 0x27, 0x2c, 0x20,
+	0x27, 0x4b, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x4d, 0x27,
+	0x2c, 0x20, 0x27, 0x4b, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4b,
+	0x52, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x4b, 0x59, 0x27, 0x2c, 0x20,
+	0x27, 0x4b, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x42, 0x27,
+	0x2c, 0x20, 0x27, 0x4c, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4c,
+	0x4b, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x4c, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x55, 0x27, 0x2c, 0x20, 0x27, 0x4c, 0x56, 0x27,
+	0x2c, 0x20, 0x27, 0x4c, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x43, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x44, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x45, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x48, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x4b, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x4d, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x4f, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x51, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x52, 0x27,
+	0x2c, 0x20, 0x27, 0x4d, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x4d,
+	0x55, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x57, 0x27, 0x2c, 0x20,
+	0x27, 0x4d, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x59, 0x27, 0x2c, 0x20, 0x27, 0x4d, 0x5a, 0x27,
+	0x2c, 0x20, 0x27, 0x4e, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x4e,
+	0x45, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x47, 0x27, 0x2c, 0x20,
+	0x27, 0x4e, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x4f, 0x27,
+	0x2c, 0x20, 0x27, 0x4e, 0x50, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x4e,
+	0x55, 0x27, 0x2c, 0x20, 0x27, 0x4e, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x4f, 0x4d, 0x27, 0x2c, 0x20,
+	0x27, 0x50, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x46, 0x27,
+	0x2c, 0x20, 0x27, 0x50, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x48, 0x27, 0x2c, 0x20, 0x27, 0x50,
+	0x4b, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x4d, 0x27, 0x2c, 0x20,
+	0x27, 0x50, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x53, 0x27,
+	0x2c, 0x20, 0x27, 0x50, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x50, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x50,
+	0x59, 0x27, 0x2c, 0x20, 0x27, 0x51, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x45, 0x27, 0x2c, 0x20,
+	0x27, 0x52, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x52, 0x55, 0x27,
+	0x2c, 0x20, 0x27, 0x52, 0x57, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x42, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x44, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x48, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x4b, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4d, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x52, 0x27,
+	0x2c, 0x20, 0x27, 0x53, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x53,
+	0x56, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x58, 0x27, 0x2c, 0x20, 0x27, 0x53, 0x59, 0x27, 0x2c, 0x20,
+	0x27, 0x53, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x44, 0x27,
+	0x2c, 0x20, 0x27, 0x54, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x47, 0x27, 0x2c, 0x20, 0x27, 0x54,
+	0x48, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4a, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4b, 0x27, 0x2c, 0x20,
+	0x27, 0x54, 0x4c, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x4e, 0x27,
+	0x2c, 0x20, 0x27, 0x54, 0x4f, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x52, 0x27, 0x2c, 0x20, 0x27, 0x54,
+	0x54, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x56, 0x27, 0x2c, 0x20, 0x27, 0x54, 0x57, 0x27, 0x2c, 0x20,
+	0x27, 0x54, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x41, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x47, 0x27,
+	0x2c, 0x20, 0x27, 0x55, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x53, 0x27, 0x2c, 0x20, 0x27, 0x55,
+	0x59, 0x27, 0x2c, 0x20, 0x27, 0x55, 0x5a, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x41, 0x27, 0x2c, 0x20,
+	0x27, 0x56, 0x43, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x47, 0x27,
+	0x2c, 0x20, 0x27, 0x56, 0x49, 0x27, 0x2c, 0x20, 0x27, 0x56, 0x4e, 0x27, 0x2c, 0x20, 0x27, 0x56,
+	0x55, 0x27, 0x2c, 0x20, 0x27, 0x57, 0x46, 0x27, 0x2c, 0x20, 0x27, 0x57, 0x53, 0x27, 0x2c, 0x20,
+	0x27, 0x59, 0x45, 0x27, 0x2c, 0x20, 0x27, 0x59, 0x54, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x41, 0x27,
+	0x2c, 0x20, 0x27, 0x5a, 0x4d, 0x27, 0x2c, 0x20, 0x27, 0x5a, 0x57, 0x27, 0x5d, 0xd8, 0x01, 0x01,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x32, 0x82, 0x02, 0x0a, 0x0b, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x0a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x26, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x0a, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x0c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0a, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x2e, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x0c, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x13, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x30, 0x01, 0x42, 0x34,
+	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x6c,
+	0x6b, 0x68, 0x6f, 0x76, 0x79, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x76, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -556,7 +1013,7 @@ func file_contract_proto_user_proto_rawDescGZIP() []byte {
 	return file_contract_proto_user_proto_rawDescData
 }
 
-var file_contract_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_contract_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_contract_proto_user_proto_goTypes = []any{
 	(*UserID)(nil),              // 0: user.UserID
 	(*User)(nil),                // 1: user.User
@@ -564,25 +1021,28 @@ var file_contract_proto_user_proto_goTypes = []any{
 	(*UserUpdate)(nil),          // 3: user.UserUpdate
 	(*Users)(nil),               // 4: user.Users
 	(*ListRequest)(nil),         // 5: user.ListRequest
-	(*timestamp.Timestamp)(nil), // 6: google.protobuf.Timestamp
-	(*empty.Empty)(nil),         // 7: google.protobuf.Empty
+	(*ExportRequest)(nil),       // 6: user.ExportRequest
+	(*timestamp.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*empty.Empty)(nil),         // 8: google.protobuf.Empty
 }
 var file_contract_proto_user_proto_depIdxs = []int32{
-	6, // 0: user.User.created_at:type_name -> google.protobuf.Timestamp
-	6, // 1: user.User.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 0: user.User.created_at:type_name -> google.protobuf.Timestamp
+	7, // 1: user.User.updated_at:type_name -> google.protobuf.Timestamp
 	1, // 2: user.Users.users:type_name -> user.User
 	2, // 3: user.UserService.Create:input_type -> user.UserInput
 	3, // 4: user.UserService.Update:input_type -> user.UserUpdate
 	0, // 5: user.UserService.Get:input_type -> user.UserID
 	5, // 6: user.UserService.List:input_type -> user.ListRequest
 	0, // 7: user.UserService.Delete:input_type -> user.UserID
-	1, // 8: user.UserService.Create:output_type -> user.User
-	1, // 9: user.UserService.Update:output_type -> user.User
-	1, // 10: user.UserService.Get:output_type -> user.User
-	4, // 11: user.UserService.List:output_type -> user.Users
-	7, // 12: user.UserService.Delete:output_type -> google.protobuf.Empty
-	8, // [8:13] is the sub-list for method output_type
-	3, // [3:8] is the sub-list for method input_type
+	6, // 8: user.UserService.Export:input_type -> user.ExportRequest
+	1, // 9: user.UserService.Create:output_type -> user.User
+	1, // 10: user.UserService.Update:output_type -> user.User
+	1, // 11: user.UserService.Get:output_type -> user.User
+	4, // 12: user.UserService.List:output_type -> user.Users
+	8, // 13: user.UserService.Delete:output_type -> google.protobuf.Empty
+	1, // 14: user.UserService.Export:output_type -> user.User
+	9, // [9:15] is the sub-list for method output_type
+	3, // [3:9] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
 	3, // [3:3] is the sub-list for extension extendee
 	0, // [0:3] is the sub-list for field type_name
@@ -599,7 +1059,7 @@ func file_contract_proto_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_contract_proto_user_proto_rawDesc), len(file_contract_proto_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,7 @@ const (
 	UserService_Get_FullMethodName    = "/user.UserService/Get"
 	UserService_List_FullMethodName   = "/user.UserService/List"
 	UserService_Delete_FullMethodName = "/user.UserService/Delete"
+	UserService_Export_FullMethodName = "/user.UserService/Export"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -36,6 +37,8 @@ type UserServiceClient interface {
 	Get(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*User, error)
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*Users, error)
 	Delete(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Export streams all users matching the filter, newest first.
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error)
 }
 
 type userServiceClient struct {
@@ -96,6 +99,25 @@ func (c *userServiceClient) Delete(ctx context.Context, in *UserID, opts ...grpc
 	return out, nil
 }
 
+func (c *userServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[User], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_Export_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRequest, User]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportClient = grpc.ServerStreamingClient[User]
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -105,6 +127,8 @@ type UserServiceServer interface {
 	Get(context.Context, *UserID) (*User, error)
 	List(context.Context, *ListRequest) (*Users, error)
 	Delete(context.Context, *UserID) (*empty.Empty, error)
+	// Export streams all users matching the filter, newest first.
+	Export(*ExportRequest, grpc.ServerStreamingServer[User]) error
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -130,6 +154,9 @@ func (UnimplementedUserServiceServer) List(context.Context, *ListRequest) (*User
 func (UnimplementedUserServiceServer) Delete(context.Context, *UserID) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUserServiceServer) Export(*ExportRequest, grpc.ServerStreamingServer[User]) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -241,6 +268,17 @@ func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).Export(m, &grpc.GenericServerStream[ExportRequest, User]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type UserService_ExportServer = grpc.ServerStreamingServer[User]
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -269,6 +307,12 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _UserService_Delete_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _UserService_Export_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "contract/proto/user.proto",
 }
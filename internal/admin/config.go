@@ -0,0 +1,31 @@
+package admin
+
+import (
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
+)
+
+// Config configures the admin server, bound to localhost by default.
+type Config struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// Pprof serves the profiles at /debug/pprof/.
+	Pprof             bool `yaml:"pprof" mapstructure:"Pprof"`
+	httpserver.Config `yaml:",inline" mapstructure:",squash"`
+}
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"Admin.Enable":            true,
+		"Admin.Pprof":             false,
+		"Admin.Host":              DefaultHost,
+		"Admin.Port":              DefaultPort,
+		"Admin.ShutdownTimeout":   httpserver.DefaultShutdownTimeout,
+		"Admin.ReadHeaderTimeout": httpserver.DefaultReadHeaderTimeout,
+		"Admin.IdleTimeout":       httpserver.DefaultIdleTimeout,
+		"Admin.MaxHeaderBytes":    httpserver.DefaultMaxHeaderBytes,
+	}
+}
+
+const (
+	DefaultHost = "127.0.0.1"
+	DefaultPort = 8090
+)
@@ -0,0 +1,138 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/otelw"
+)
+
+// BuildInfo describes the running binary.
+type BuildInfo struct {
+	Service   string `json:"service"`
+	Version   string `json:"version"`
+	Revision  string `json:"revision"`
+	Time      string `json:"time"`
+	GoVersion string `json:"goVersion"`
+}
+
+// LogLevel changes the log level at runtime.
+type LogLevel interface {
+	Level() string
+	SetLevel(ctx context.Context, level string) error
+}
+
+// Controller serves the admin endpoints, it must not be exposed publicly.
+type Controller struct {
+	buildInfo BuildInfo
+	config    any
+	logLevel  LogLevel
+	pprof     bool
+	mux       *http.ServeMux
+}
+
+// New creates the admin router serving the log level, build info, the service
+// configuration with secrets redacted, the health checks of registry, and
+// profiles when enabled.
+func New(buildInfo BuildInfo, config any, logLevel LogLevel, registry *health.Registry, options ...Option) *Controller {
+	if buildInfo.GoVersion == "" {
+		buildInfo.GoVersion = runtime.Version()
+	}
+
+	controller := Controller{
+		buildInfo: buildInfo,
+		config:    Redact(config),
+		logLevel:  logLevel,
+		mux:       http.NewServeMux(),
+	}
+
+	for _, option := range options {
+		option(&controller)
+	}
+
+	// Profiles.
+	if controller.pprof {
+		controller.mux.HandleFunc("/debug/pprof/", pprof.Index)
+		controller.mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		controller.mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		controller.mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		controller.mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+
+	// Runtime state.
+	controller.mux.HandleFunc("GET /admin/loglevel", controller.getLogLevel)
+	controller.mux.HandleFunc("PUT /admin/loglevel", controller.putLogLevel)
+	controller.mux.HandleFunc("GET /admin/buildinfo", controller.getBuildInfo)
+	controller.mux.HandleFunc("GET /admin/config", controller.getConfig)
+
+	// Health checks.
+	if registry != nil {
+		controller.mux.HandleFunc("GET "+health.LivenessPath, registry.ServeLiveness)
+		controller.mux.HandleFunc("GET "+health.ReadinessPath, registry.ServeReadiness)
+	}
+
+	return &controller
+}
+
+func (c *Controller) Handler() http.Handler {
+	return c.mux
+}
+
+type logLevel struct {
+	Level string `json:"level"`
+}
+
+func (c *Controller) getLogLevel(writer http.ResponseWriter, _ *http.Request) {
+	writeJSON(writer, http.StatusOK, logLevel{Level: c.logLevel.Level()})
+}
+
+func (c *Controller) putLogLevel(writer http.ResponseWriter, request *http.Request) {
+	var level logLevel
+
+	if err := json.NewDecoder(request.Body).Decode(&level); err != nil {
+		writeError(writer, http.StatusBadRequest, fmt.Errorf("%w: %w", ErrMalformedRequest, err))
+
+		return
+	}
+
+	if err := c.logLevel.SetLevel(request.Context(), level.Level); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, otelw.ErrInvalidLevel) {
+			status = http.StatusBadRequest
+		}
+
+		writeError(writer, status, err)
+
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, logLevel{Level: c.logLevel.Level()})
+}
+
+func (c *Controller) getBuildInfo(writer http.ResponseWriter, _ *http.Request) {
+	writeJSON(writer, http.StatusOK, c.buildInfo)
+}
+
+func (c *Controller) getConfig(writer http.ResponseWriter, _ *http.Request) {
+	writeJSON(writer, http.StatusOK, c.config)
+}
+
+func writeJSON(writer http.ResponseWriter, status int, body any) {
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(body)
+}
+
+func writeError(writer http.ResponseWriter, status int, err error) {
+	writeJSON(writer, status, struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
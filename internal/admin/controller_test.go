@@ -0,0 +1,118 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/otelw"
+)
+
+type fakeLogLevel struct {
+	level string
+}
+
+func (f *fakeLogLevel) Level() string {
+	return f.level
+}
+
+func (f *fakeLogLevel) SetLevel(_ context.Context, level string) error {
+	if !slices.Contains(otelw.Levels, level) {
+		return fmt.Errorf("%w: %s", otelw.ErrInvalidLevel, level)
+	}
+
+	f.level = level
+
+	return nil
+}
+
+type testConfig struct {
+	Embedded `yaml:",inline"`
+	Postgres struct {
+		Host     string `yaml:"host"`
+		Password string `yaml:"password"`
+	} `yaml:"postgres"`
+	Timeout time.Duration `yaml:"timeout"`
+	Brokers []string      `yaml:"brokers"`
+	secret  string
+}
+
+type Embedded struct {
+	Level string `mapstructure:"Level"`
+}
+
+func TestController(t *testing.T) {
+	t.Parallel()
+
+	config := testConfig{Embedded: Embedded{Level: "info"}, Timeout: time.Second, Brokers: []string{"kafka:9092"}, secret: "s"}
+	config.Postgres.Host = "postgres"
+	config.Postgres.Password = "postgres"
+
+	logLevel := &fakeLogLevel{level: "info"}
+	controller := New(BuildInfo{Service: "user-rest", Version: "v1.2.3"}, &config, logLevel, health.New(health.Config{}), WithPprof())
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		controller.Handler().ServeHTTP(recorder, httptest.NewRequest(method, target, strings.NewReader(body)))
+
+		return recorder
+	}
+
+	// Log level.
+	recorder := serve(http.MethodGet, "/admin/loglevel", "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"level":"info"}`, recorder.Body.String())
+
+	recorder = serve(http.MethodPut, "/admin/loglevel", `{"level":"debug"}`)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"level":"debug"}`, recorder.Body.String())
+	assert.Equal(t, "debug", logLevel.level)
+
+	recorder = serve(http.MethodPut, "/admin/loglevel", `{"level":"verbose"}`)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), otelw.ErrInvalidLevel.Error())
+
+	recorder = serve(http.MethodPut, "/admin/loglevel", `{`)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// Build info.
+	var buildInfo BuildInfo
+
+	recorder = serve(http.MethodGet, "/admin/buildinfo", "")
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &buildInfo))
+	assert.Equal(t, "v1.2.3", buildInfo.Version)
+	assert.NotEmpty(t, buildInfo.GoVersion)
+
+	// Configuration, secrets redacted.
+	recorder = serve(http.MethodGet, "/admin/config", "")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{
+		"Level": "info",
+		"postgres": {"host": "postgres", "password": "[REDACTED]"},
+		"timeout": "1s",
+		"brokers": ["kafka:9092"]
+	}`, recorder.Body.String())
+
+	// Profiles and health checks.
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/debug/pprof/", "").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, health.ReadinessPath, "").Code)
+}
+
+func TestController_NoPprof(t *testing.T) {
+	t.Parallel()
+
+	controller := New(BuildInfo{}, struct{}{}, &fakeLogLevel{level: "info"}, nil)
+
+	recorder := httptest.NewRecorder()
+	controller.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
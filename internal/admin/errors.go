@@ -0,0 +1,5 @@
+package admin
+
+import "errors"
+
+var ErrMalformedRequest = errors.New("malformed request")
@@ -0,0 +1,11 @@
+package admin
+
+// Option configures the admin router.
+type Option func(*Controller)
+
+// WithPprof serves the profiles at /debug/pprof/, they are not served without it.
+func WithPprof() Option {
+	return func(c *Controller) {
+		c.pprof = true
+	}
+}
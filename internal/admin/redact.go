@@ -0,0 +1,122 @@
+package admin
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Redacted replaces the values of secret fields.
+const Redacted = "[REDACTED]"
+
+// secretNames are the field name fragments of secrets, compared case-insensitively.
+//
+//nolint:gochecknoglobals
+var secretNames = []string{"password", "secret", "token", "credential", "privatekey", "apikeys"}
+
+// Redact converts a configuration to a map keyed by the configuration file keys,
+// with the values of secret fields replaced.
+func Redact(config any) any {
+	return redact(reflect.ValueOf(config), false)
+}
+
+//nolint:cyclop,exhaustive
+func redact(value reflect.Value, secret bool) any {
+	if !value.IsValid() {
+		return nil
+	}
+
+	if duration, ok := value.Interface().(time.Duration); ok {
+		return duration.String()
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+
+		return redact(value.Elem(), secret)
+	case reflect.Struct:
+		fields := make(map[string]any)
+		redactStruct(value, fields)
+
+		return fields
+	case reflect.Map:
+		entries := make(map[string]any, value.Len())
+
+		iter := value.MapRange()
+		for iter.Next() {
+			key := iter.Key().String()
+			entries[key] = redact(iter.Value(), secret || isSecret(key))
+		}
+
+		return entries
+	case reflect.Slice, reflect.Array:
+		items := make([]any, value.Len())
+
+		for i := range value.Len() {
+			items[i] = redact(value.Index(i), secret)
+		}
+
+		return items
+	default:
+		if secret && !value.IsZero() {
+			return Redacted
+		}
+
+		return value.Interface()
+	}
+}
+
+// redactStruct adds the exported fields of a struct to fields, inlined fields are flattened.
+func redactStruct(value reflect.Value, fields map[string]any) {
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, inline := fieldName(field)
+		if inline && value.Field(i).Kind() == reflect.Struct {
+			redactStruct(value.Field(i), fields)
+
+			continue
+		}
+
+		fields[name] = redact(value.Field(i), isSecret(name))
+	}
+}
+
+// fieldName is the yaml key of a field, falling back to the mapstructure key and the field name.
+func fieldName(field reflect.StructField) (string, bool) {
+	for _, key := range []string{"yaml", "mapstructure"} {
+		tag, ok := field.Tag.Lookup(key)
+		if !ok {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if options == "inline" || options == "squash" {
+			return field.Name, true
+		}
+
+		if name != "" {
+			return name, false
+		}
+	}
+
+	return field.Name, field.Anonymous
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, secret := range secretNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+
+	return false
+}
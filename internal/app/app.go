@@ -0,0 +1,261 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-utilities/osx"
+	"golang.org/x/sync/errgroup"
+)
+
+// Hook is a component lifecycle function.
+type Hook func(ctx context.Context) error
+
+// Component is a part of the application. Components are started after the
+// components they depend on and stopped in reverse order, Run functions of all
+// started components run concurrently until one fails or a signal arrives.
+// Once a signal arrives, Drain hooks are called and the Run contexts are
+// cancelled after the drain delay. Hooks are optional.
+type Component struct {
+	Name      string
+	DependsOn []string
+	Start     Hook
+	Run       Hook
+	Drain     Hook
+	Stop      Hook
+}
+
+// App runs components with a global shutdown deadline.
+type App struct {
+	config     Config
+	components []Component
+}
+
+func New(config Config) *App {
+	if config.ShutdownTimeout <= 0 {
+		config.ShutdownTimeout = DefaultShutdownTimeout
+	}
+
+	return &App{config: config}
+}
+
+// Add registers components, the order of independent components is kept.
+func (a *App) Add(components ...Component) {
+	a.components = append(a.components, components...)
+}
+
+// Run starts the components, runs them until SIGTERM or SIGINT arrives, ctx is
+// done or one of them fails, stops them and returns the exit code: osx.ExitSuccess,
+// osx.ExitTimeout when the shutdown deadline is exceeded or osx.ExitFailure.
+func (a *App) Run(ctx context.Context) int {
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	components, err := a.order()
+	if err != nil {
+		slogw.DefaultLogger().ErrorContext(ctx, "app",
+			slog.String("order", err.Error()),
+		)
+
+		return osx.ExitFailure
+	}
+
+	started := make([]Component, 0, len(components))
+
+	for _, component := range components {
+		if err = hook(ctx, component, "start", component.Start); err != nil {
+			break
+		}
+
+		started = append(started, component)
+	}
+
+	deadline := time.Now().Add(a.config.ShutdownTimeout)
+
+	if err == nil {
+		deadline, err = a.run(ctx, started)
+	}
+
+	// Stop hooks share the shutdown deadline, they run even when it is exceeded.
+	stopCtx, cancel := context.WithDeadline(context.WithoutCancel(ctx), deadline)
+	defer cancel()
+
+	for i := len(started) - 1; i >= 0; i-- {
+		err = errors.Join(err, hook(stopCtx, started[i], "stop", started[i].Stop))
+	}
+
+	if stopCtx.Err() != nil && !errors.Is(err, ErrShutdownTimeout) {
+		err = errors.Join(err, ErrShutdownTimeout)
+	}
+
+	exitCode := exitCode(err)
+
+	slogw.DefaultLogger().InfoContext(ctx, "exiting",
+		slog.Int("code", exitCode),
+	)
+
+	return exitCode
+}
+
+// run runs the components until one fails or ctx is done and returns the
+// shutdown deadline. When ctx is done, the components are drained first.
+func (a *App) run(ctx context.Context, components []Component) (time.Time, error) {
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+
+	group, groupCtx := errgroup.WithContext(runCtx)
+
+	for _, component := range components {
+		if component.Run != nil {
+			group.Go(func() error { return hook(groupCtx, component, "run", component.Run) })
+		}
+	}
+
+	done := make(chan error, 1)
+
+	go func() { done <- group.Wait() }()
+
+	select {
+	case err := <-done:
+		return time.Now().Add(a.config.ShutdownTimeout), err
+	case <-groupCtx.Done():
+	case <-ctx.Done():
+		a.drain(ctx, groupCtx, components)
+	}
+
+	cancel()
+
+	deadline := time.Now().Add(a.config.ShutdownTimeout)
+
+	timer := time.NewTimer(a.config.ShutdownTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return deadline, err
+	case <-timer.C:
+		slogw.DefaultLogger().ErrorContext(ctx, "app",
+			slog.String("run", ErrShutdownTimeout.Error()),
+		)
+
+		return deadline, ErrShutdownTimeout
+	}
+}
+
+// drain calls the drain hooks and waits for the drain delay,
+// a component failing meanwhile ends the wait.
+func (a *App) drain(ctx, groupCtx context.Context, components []Component) {
+	drainCtx := context.WithoutCancel(ctx)
+
+	for _, component := range components {
+		_ = hook(drainCtx, component, "drain", component.Drain)
+	}
+
+	if a.config.DrainDelay <= 0 {
+		return
+	}
+
+	slogw.DefaultLogger().InfoContext(ctx, "app",
+		slog.Duration("drain", a.config.DrainDelay),
+	)
+
+	timer := time.NewTimer(a.config.DrainDelay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-groupCtx.Done():
+	}
+}
+
+// order sorts the components by dependencies.
+func (a *App) order() ([]Component, error) {
+	components := make(map[string]Component, len(a.components))
+
+	for _, component := range a.components {
+		if _, ok := components[component.Name]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateComponent, component.Name)
+		}
+
+		components[component.Name] = component
+	}
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[string]int, len(a.components))
+	ordered := make([]Component, 0, len(a.components))
+
+	var visit func(component Component) error
+
+	visit = func(component Component) error {
+		switch state[component.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("%w: %s", ErrDependencyCycle, component.Name)
+		}
+
+		state[component.Name] = visiting
+
+		for _, name := range component.DependsOn {
+			dependency, ok := components[name]
+			if !ok {
+				return fmt.Errorf("%w: %s depends on %s", ErrUnknownDependency, component.Name, name)
+			}
+
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+
+		state[component.Name] = visited
+		ordered = append(ordered, component)
+
+		return nil
+	}
+
+	for _, component := range a.components {
+		if err := visit(component); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+// hook calls a component hook and logs its error.
+func hook(ctx context.Context, component Component, stage string, fn Hook) error {
+	if fn == nil {
+		return nil
+	}
+
+	if err := fn(ctx); err != nil {
+		slogw.DefaultLogger().ErrorContext(ctx, component.Name,
+			slog.String(stage, err.Error()),
+		)
+
+		return fmt.Errorf("%s %s: %w", component.Name, stage, err)
+	}
+
+	return nil
+}
+
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return osx.ExitSuccess
+	case errors.Is(err, ErrShutdownTimeout):
+		return osx.ExitTimeout
+	default:
+		return osx.ExitFailure
+	}
+}
@@ -0,0 +1,252 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-utilities/osx"
+)
+
+// recorder records lifecycle events of components.
+type recorder struct {
+	mutex  sync.Mutex
+	events []string
+}
+
+func (r *recorder) record(event string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *recorder) all() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return append([]string(nil), r.events...)
+}
+
+func (r *recorder) component(name string, run Hook, dependsOn ...string) Component {
+	return Component{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(context.Context) error {
+			r.record("start " + name)
+
+			return nil
+		},
+		Run: run,
+		Stop: func(context.Context) error {
+			r.record("stop " + name)
+
+			return nil
+		},
+	}
+}
+
+func waitDone(ctx context.Context) error {
+	<-ctx.Done()
+
+	return nil
+}
+
+func TestApp_Run(t *testing.T) {
+	t.Parallel()
+
+	events := recorder{}
+
+	app := New(Config{ShutdownTimeout: time.Second})
+	app.Add(
+		events.component("server", waitDone, "domain"),
+		events.component("domain", nil, "telemetry"),
+		events.component("telemetry", nil),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	assert.Equal(t, osx.ExitSuccess, app.Run(ctx))
+	assert.Equal(t, []string{
+		"start telemetry", "start domain", "start server",
+		"stop server", "stop domain", "stop telemetry",
+	}, events.all())
+}
+
+func TestApp_Drain(t *testing.T) {
+	t.Parallel()
+
+	const drainDelay = 50 * time.Millisecond
+
+	events := recorder{}
+
+	var drained time.Time
+
+	health := events.component("health", nil)
+	health.Drain = func(context.Context) error {
+		drained = time.Now()
+
+		events.record("drain health")
+
+		return nil
+	}
+
+	app := New(Config{DrainDelay: drainDelay, ShutdownTimeout: time.Second})
+	app.Add(
+		health,
+		events.component("server", func(ctx context.Context) error {
+			<-ctx.Done()
+
+			// Servers stop once the drain delay passed.
+			assert.GreaterOrEqual(t, time.Since(drained), drainDelay)
+			events.record("server done")
+
+			return nil
+		}, "health"),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	assert.Equal(t, osx.ExitSuccess, app.Run(ctx))
+	assert.Equal(t, []string{
+		"start health", "start server",
+		"drain health", "server done",
+		"stop server", "stop health",
+	}, events.all())
+}
+
+func TestApp_StartFailure(t *testing.T) {
+	t.Parallel()
+
+	events := recorder{}
+
+	failing := events.component("domain", nil, "telemetry")
+	failing.Start = func(context.Context) error { return errors.New("connection refused") } //nolint:err113
+
+	app := New(Config{ShutdownTimeout: time.Second})
+	app.Add(
+		events.component("telemetry", nil),
+		failing,
+		events.component("server", waitDone, "domain"),
+	)
+
+	// Components started before the failure are stopped, nothing runs.
+	assert.Equal(t, osx.ExitFailure, app.Run(context.Background()))
+	assert.Equal(t, []string{"start telemetry", "stop telemetry"}, events.all())
+}
+
+func TestApp_RunFailure(t *testing.T) {
+	t.Parallel()
+
+	events := recorder{}
+
+	app := New(Config{ShutdownTimeout: time.Second})
+	app.Add(
+		events.component("admin", waitDone),
+		events.component("server", func(context.Context) error {
+			return errors.New("address already in use") //nolint:err113
+		}),
+	)
+
+	// A failing component stops the others.
+	assert.Equal(t, osx.ExitFailure, app.Run(context.Background()))
+	assert.Equal(t, []string{"start admin", "start server", "stop server", "stop admin"}, events.all())
+}
+
+func TestApp_ShutdownTimeout(t *testing.T) {
+	t.Parallel()
+
+	events := recorder{}
+	release := make(chan struct{})
+
+	defer close(release)
+
+	app := New(Config{ShutdownTimeout: 20 * time.Millisecond})
+	app.Add(
+		events.component("telemetry", nil),
+		events.component("server", func(context.Context) error {
+			<-release
+
+			return nil
+		}, "telemetry"),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Stop hooks run although the deadline is exceeded.
+	assert.Equal(t, osx.ExitTimeout, app.Run(ctx))
+	assert.Equal(t, []string{
+		"start telemetry", "start server", "stop server", "stop telemetry",
+	}, events.all())
+}
+
+func TestApp_Order(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		components []Component
+		err        error
+	}{
+		{
+			name:       "duplicate",
+			components: []Component{{Name: "domain"}, {Name: "domain"}},
+			err:        ErrDuplicateComponent,
+		},
+		{
+			name:       "unknown dependency",
+			components: []Component{{Name: "domain", DependsOn: []string{"telemetry"}}},
+			err:        ErrUnknownDependency,
+		},
+		{
+			name: "cycle",
+			components: []Component{
+				{Name: "domain", DependsOn: []string{"server"}},
+				{Name: "server", DependsOn: []string{"domain"}},
+			},
+			err: ErrDependencyCycle,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := New(Config{})
+			app.Add(test.components...)
+
+			_, err := app.order()
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, osx.ExitFailure, app.Run(context.Background()))
+		})
+	}
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ran := false
+
+	app := New(Config{ShutdownTimeout: time.Second})
+	app.Add(Server("server", func(context.Context) (server.Contract, error) {
+		return serverFunc(func(context.Context) error {
+			ran = true
+
+			return nil
+		}), nil
+	}))
+
+	assert.Equal(t, osx.ExitSuccess, app.Run(context.Background()))
+	assert.True(t, ran)
+}
+
+type serverFunc func(ctx context.Context) error
+
+func (f serverFunc) Run(ctx context.Context) error { return f(ctx) }
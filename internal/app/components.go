@@ -0,0 +1,345 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	gootelw "github.com/yolkhovyy/go-otelw/otelw"
+	"github.com/yolkhovyy/go-otelw/otelw/metricw"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-otelw/otelw/tracew"
+	"github.com/yolkhovyy/go-userv/internal/admin"
+	cdomain "github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/contract/server"
+	"github.com/yolkhovyy/go-userv/internal/domain"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/notifier"
+	"github.com/yolkhovyy/go-userv/internal/otelw"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
+	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// Component names.
+const (
+	TelemetryName   = "telemetry"
+	HealthName      = "health"
+	DomainName      = "domain"
+	RateLimitName   = "rate limit"
+	IdempotencyName = "idempotency"
+	BroadcasterName = "broadcaster"
+	AdminName       = "admin"
+)
+
+// Server is a component running the server created on start.
+func Server(name string, newServer func(ctx context.Context) (server.Contract, error), dependsOn ...string) Component {
+	var srv server.Contract
+
+	return Component{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(ctx context.Context) error {
+			var err error
+
+			srv, err = newServer(ctx)
+
+			return err
+		},
+		Run: func(ctx context.Context) error {
+			return srv.Run(ctx)
+		},
+	}
+}
+
+// Health drains the registry once shutdown starts, so that readiness fails
+// during the drain delay, before the servers stop. It also serves the checks
+// on their own port when the server is enabled.
+func Health(config health.ServerConfig, registry *health.Registry) Component {
+	var srv server.Contract
+
+	return Component{
+		Name:      HealthName,
+		DependsOn: []string{TelemetryName},
+		Start: func(context.Context) error {
+			if config.Enable {
+				srv = httpserver.New(config.Config, registry.Handler())
+			}
+
+			return nil
+		},
+		Run: func(ctx context.Context) error {
+			if srv == nil {
+				return nil
+			}
+
+			return srv.Run(ctx)
+		},
+		Drain: func(context.Context) error {
+			registry.Drain()
+
+			return nil
+		},
+	}
+}
+
+// Telemetry configures logging, tracing and metrics. Other components depend
+// on it, so that it is stopped last.
+type Telemetry struct {
+	Logger     *slogw.Logger
+	config     gootelw.Config
+	buildInfo  admin.BuildInfo
+	attributes []attribute.KeyValue
+	tracer     *tracew.Tracer
+	metric     *metricw.Metric
+}
+
+func NewTelemetry(config gootelw.Config, buildInfo admin.BuildInfo) *Telemetry {
+	return &Telemetry{
+		config:    config,
+		buildInfo: buildInfo,
+		attributes: []attribute.KeyValue{
+			semconv.ServiceNameKey.String(buildInfo.Service),
+			semconv.ServiceVersionKey.String(buildInfo.Version),
+		},
+	}
+}
+
+func (t *Telemetry) Component() Component {
+	return Component{
+		Name: TelemetryName,
+		Start: func(ctx context.Context) error {
+			var err error
+
+			t.Logger, t.tracer, t.metric, err = otelw.Configure(ctx, t.config, t.attributes)
+			if err != nil {
+				return fmt.Errorf("otelw configure: %w", err)
+			}
+
+			t.Logger.InfoContext(ctx, "build info",
+				slog.String("version", t.buildInfo.Version),
+				slog.String("time", t.buildInfo.Time),
+				slog.String("commit", t.buildInfo.Revision),
+			)
+
+			return nil
+		},
+		Stop: func(ctx context.Context) error {
+			err := errors.Join(
+				t.metric.Shutdown(ctx),
+				t.tracer.Shutdown(ctx),
+				t.Logger.Shutdown(ctx))
+			if err != nil {
+				return fmt.Errorf("otelw shutdown: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// Domain is the user domain, it is ready when storage is.
+type Domain struct {
+	cdomain.Contract
+	config   postgres.Config
+	registry *health.Registry
+}
+
+func NewDomain(config postgres.Config, registry *health.Registry) *Domain {
+	return &Domain{config: config, registry: registry}
+}
+
+func (d *Domain) Component() Component {
+	return Component{
+		Name:      DomainName,
+		DependsOn: []string{TelemetryName},
+		Start: func(ctx context.Context) error {
+			var err error
+
+			d.Contract, err = domain.New(ctx, d.config)
+			if err != nil {
+				return fmt.Errorf("domain new: %w", err)
+			}
+
+			d.registry.AddReadiness("postgres", d.Contract)
+
+			return nil
+		},
+		Stop: func(context.Context) error {
+			return d.Close()
+		},
+	}
+}
+
+// RateLimiter limits the request rate of clients, Limiter is nil when disabled.
+type RateLimiter struct {
+	*ratelimit.Limiter
+	config ratelimit.Config
+}
+
+func NewRateLimiter(config ratelimit.Config) *RateLimiter {
+	return &RateLimiter{config: config}
+}
+
+func (r *RateLimiter) Component() Component {
+	return Component{
+		Name:      RateLimitName,
+		DependsOn: []string{TelemetryName},
+		Start: func(context.Context) error {
+			if !r.config.Enable {
+				return nil
+			}
+
+			var err error
+
+			r.Limiter, err = ratelimit.New(r.config)
+			if err != nil {
+				return fmt.Errorf("rate limit new: %w", err)
+			}
+
+			return nil
+		},
+		Stop: func(context.Context) error {
+			if r.Limiter == nil {
+				return nil
+			}
+
+			return r.Close()
+		},
+	}
+}
+
+// IdempotencyStore keeps responses of requests retried with an idempotency key,
+// Store is nil when disabled.
+type IdempotencyStore struct {
+	idempotency.Store
+	config         idempotency.Config
+	postgresConfig postgres.Config
+}
+
+func NewIdempotencyStore(config idempotency.Config, postgresConfig postgres.Config) *IdempotencyStore {
+	return &IdempotencyStore{config: config, postgresConfig: postgresConfig}
+}
+
+func (i *IdempotencyStore) Component() Component {
+	return Component{
+		Name:      IdempotencyName,
+		DependsOn: []string{TelemetryName},
+		Start: func(ctx context.Context) error {
+			if !i.config.Enable {
+				return nil
+			}
+
+			switch i.config.Store {
+			case idempotency.StorePostgres, "":
+				store, err := idempotency.NewPostgres(ctx, i.config, i.postgresConfig)
+				if err != nil {
+					return fmt.Errorf("idempotency new: %w", err)
+				}
+
+				i.Store = store
+			case idempotency.StoreMemory:
+				i.Store = idempotency.NewMemory(i.config)
+			default:
+				return fmt.Errorf("idempotency new: %w: %s", idempotency.ErrInvalidStore, i.config.Store)
+			}
+
+			return nil
+		},
+		Stop: func(context.Context) error {
+			if i.Store == nil {
+				return nil
+			}
+
+			return i.Close()
+		},
+	}
+}
+
+// Broadcaster feeds GraphQL subscriptions from storage changes when enabled,
+// Broadcaster is nil when disabled.
+type Broadcaster struct {
+	*notifier.Broadcaster
+	enable bool
+	config postgres.Config
+}
+
+func NewBroadcaster(enable bool, config postgres.Config) *Broadcaster {
+	return &Broadcaster{enable: enable, config: config}
+}
+
+func (b *Broadcaster) Component() Component {
+	return Component{
+		Name:      BroadcasterName,
+		DependsOn: []string{TelemetryName},
+		Start: func(context.Context) error {
+			if !b.enable {
+				return nil
+			}
+
+			var err error
+
+			b.Broadcaster, err = notifier.NewBroadcaster(b.config)
+			if err != nil {
+				return fmt.Errorf("broadcaster new: %w", err)
+			}
+
+			return nil
+		},
+		Run: func(ctx context.Context) error {
+			if b.Broadcaster == nil {
+				return nil
+			}
+
+			return b.Broadcaster.Run(ctx)
+		},
+	}
+}
+
+// Admin runs the admin server when enabled, it must not be exposed publicly.
+func Admin(config admin.Config, telemetry *Telemetry, serviceConfig any, registry *health.Registry) Component {
+	var (
+		logLevel *otelw.LogLevel
+		srv      server.Contract
+	)
+
+	return Component{
+		Name:      AdminName,
+		DependsOn: []string{TelemetryName},
+		Start: func(context.Context) error {
+			if !config.Enable {
+				return nil
+			}
+
+			logLevel = otelw.NewLogLevel(telemetry.config.Logger, telemetry.Logger, telemetry.attributes)
+
+			var options []admin.Option
+			if config.Pprof {
+				options = append(options, admin.WithPprof())
+			}
+
+			router := admin.New(telemetry.buildInfo, serviceConfig, logLevel, registry, options...)
+			srv = httpserver.New(config.Config, router.Handler())
+
+			return nil
+		},
+		Run: func(ctx context.Context) error {
+			if srv == nil {
+				return nil
+			}
+
+			return srv.Run(ctx)
+		},
+		Stop: func(ctx context.Context) error {
+			if logLevel == nil {
+				return nil
+			}
+
+			return logLevel.Shutdown(ctx)
+		},
+	}
+}
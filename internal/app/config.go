@@ -0,0 +1,25 @@
+package app
+
+import "time"
+
+type Config struct {
+	// DrainDelay is the time between the start of shutdown, when readiness starts
+	// failing, and the stop of the servers, so that load balancers stop routing
+	// new requests first. It precedes ShutdownTimeout.
+	DrainDelay time.Duration `yaml:"drainDelay" mapstructure:"DrainDelay"`
+	// ShutdownTimeout bounds the shutdown of all components, servers returning
+	// and stop hooks included.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" mapstructure:"ShutdownTimeout"`
+}
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"App.DrainDelay":      DefaultDrainDelay,
+		"App.ShutdownTimeout": DefaultShutdownTimeout,
+	}
+}
+
+const (
+	DefaultDrainDelay      = 5 * time.Second
+	DefaultShutdownTimeout = 15 * time.Second
+)
@@ -0,0 +1,10 @@
+package app
+
+import "errors"
+
+var (
+	ErrDuplicateComponent = errors.New("duplicate component")
+	ErrUnknownDependency  = errors.New("unknown dependency")
+	ErrDependencyCycle    = errors.New("dependency cycle")
+	ErrShutdownTimeout    = errors.New("shutdown timeout")
+)
@@ -6,12 +6,14 @@ import (
 	"io"
 
 	"github.com/google/uuid"
+	"github.com/yolkhovyy/go-userv/internal/contract/health"
 	"github.com/yolkhovyy/go-userv/internal/contract/storage"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type Contract interface {
 	CRUD
+	health.Checker
 	io.Closer
 }
 
@@ -28,7 +30,10 @@ type Creator interface {
 
 type Reader interface {
 	Get(ctx context.Context, userID uuid.UUID) (*User, error)
+	GetMany(ctx context.Context, userIDs []uuid.UUID) ([]User, error)
 	List(ctx context.Context, page, limit int, countryCode string) (*UserList, error)
+	Export(ctx context.Context, countryCode string, yield func(User) error) error
+	Page(ctx context.Context, query PageQuery) (*UserPage, error)
 }
 
 type Updater interface {
@@ -47,6 +52,30 @@ type UserInput storage.UserInput
 
 type UserUpdate storage.UserUpdate
 
+// UserEvent is a user change captured from storage.
+type UserEvent struct {
+	Operation string `json:"event"`
+	User
+}
+
+// UserEvent operations, as reported by the storage trigger.
+const (
+	UserCreated = "INSERT"
+	UserUpdated = "UPDATE"
+	UserDeleted = "DELETE"
+)
+
+type PageQuery storage.PageQuery
+
+type Cursor = storage.Cursor
+
+type UserPage struct {
+	Users       []User
+	HasNext     bool
+	HasPrevious bool
+	TotalCount  int
+}
+
 type UserList struct {
 	Users      []User `json:"users,omitempty"`
 	TotalCount int    `json:"totalCount"`
@@ -65,6 +94,10 @@ func UserUpdateToStorage(userUpdate UserUpdate) storage.UserUpdate {
 	return storage.UserUpdate(userUpdate)
 }
 
+func PageQueryToStorage(query PageQuery) storage.PageQuery {
+	return storage.PageQuery(query)
+}
+
 func UsersFromStorage(storageUsers []storage.User) []User {
 	users := make([]User, len(storageUsers))
 
@@ -0,0 +1,8 @@
+package domain
+
+import "github.com/yolkhovyy/go-userv/internal/contract/storage"
+
+var (
+	ErrNotFound = storage.ErrNotFound
+	ErrConflict = storage.ErrConflict
+)
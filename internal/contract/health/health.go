@@ -0,0 +1,10 @@
+package health
+
+import "context"
+
+// Checker checks a dependency of a service.
+type Checker interface {
+	// Check returns details reported along with the check status,
+	// e.g. connection pool statistics, and an error when the dependency is unavailable.
+	Check(ctx context.Context) (any, error)
+}
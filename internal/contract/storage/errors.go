@@ -0,0 +1,8 @@
+package storage
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("user not found")
+	ErrConflict = errors.New("user conflict")
+)
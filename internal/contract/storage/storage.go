@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/yolkhovyy/go-userv/internal/contract/health"
 )
 
 type Contract interface {
 	CRUD
+	health.Checker
 	io.Closer
 }
 
@@ -26,7 +28,14 @@ type Creator interface {
 
 type Reader interface {
 	Get(ctx context.Context, userID uuid.UUID) (*User, error)
+	// GetMany returns the users with the given ids in no particular order, unknown ids are skipped.
+	GetMany(ctx context.Context, userIDs []uuid.UUID) ([]User, error)
 	List(ctx context.Context, page, limit int, countryCode string) ([]User, int, error)
+	// Export calls yield for each user matching the country filter, newest first,
+	// and stops at the first error returned by yield.
+	Export(ctx context.Context, countryCode string, yield func(User) error) error
+	// Page returns a keyset page of users ordered by creation time, newest first.
+	Page(ctx context.Context, query PageQuery) (*UserPage, error)
 }
 
 type Updater interface {
@@ -72,3 +81,26 @@ type UserList struct {
 	TotalCount int    `json:"totalCount"`
 	NextPage   int    `json:"nextPage"`
 }
+
+// PageQuery selects the First users after the After cursor or,
+// if First is zero, the Last users before the Before cursor.
+type PageQuery struct {
+	Country string
+	First   int
+	After   *Cursor
+	Last    int
+	Before  *Cursor
+}
+
+// Cursor is the position of a user in the creation time order.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+type UserPage struct {
+	Users       []User
+	HasNext     bool
+	HasPrevious bool
+	TotalCount  int
+}
@@ -41,3 +41,12 @@ func (u Controller) Close() error {
 
 	return errAll
 }
+
+func (u Controller) Check(ctx context.Context) (any, error) {
+	details, err := u.storage.Check(ctx)
+	if err != nil {
+		return details, fmt.Errorf("storage: %w", err)
+	}
+
+	return details, nil
+}
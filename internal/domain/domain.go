@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
 	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/contract/storage"
 )
 
 func (u Controller) Create(ctx context.Context, userInput domain.UserInput) (*domain.User, error) {
@@ -75,6 +76,20 @@ func (u Controller) Get(ctx context.Context, userID uuid.UUID) (*domain.User, er
 	return &domainUser, nil
 }
 
+func (u Controller) GetMany(ctx context.Context, userIDs []uuid.UUID) ([]domain.User, error) {
+	users, err := u.storage.GetMany(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+
+	slogw.DefaultLogger().InfoContext(ctx, "retrieved",
+		slog.Int("requested", len(userIDs)),
+		slog.Int("found", len(users)),
+	)
+
+	return domain.UsersFromStorage(users), nil
+}
+
 func (u Controller) List(ctx context.Context, page, limit int, countryCode string) (*domain.UserList, error) {
 	users, count, err := u.storage.List(ctx, page, limit, countryCode)
 	if err != nil {
@@ -104,6 +119,44 @@ func (u Controller) List(ctx context.Context, page, limit int, countryCode strin
 	return &listUsers, nil
 }
 
+func (u Controller) Export(ctx context.Context, countryCode string, yield func(domain.User) error) error {
+	var count int
+
+	err := u.storage.Export(ctx, countryCode, func(user storage.User) error {
+		count++
+
+		return yield(domain.UserFromStorage(user))
+	})
+	if err != nil {
+		return fmt.Errorf("export users: %w", err)
+	}
+
+	slogw.DefaultLogger().InfoContext(ctx, "exported",
+		slog.Int("number of users", count),
+	)
+
+	return nil
+}
+
+func (u Controller) Page(ctx context.Context, query domain.PageQuery) (*domain.UserPage, error) {
+	page, err := u.storage.Page(ctx, domain.PageQueryToStorage(query))
+	if err != nil {
+		return nil, fmt.Errorf("page users: %w", err)
+	}
+
+	slogw.DefaultLogger().InfoContext(ctx, "retrieved",
+		slog.Int("number of users", len(page.Users)),
+		slog.Int("total count", page.TotalCount),
+	)
+
+	return &domain.UserPage{
+		Users:       domain.UsersFromStorage(page.Users),
+		HasNext:     page.HasNext,
+		HasPrevious: page.HasPrevious,
+		TotalCount:  page.TotalCount,
+	}, nil
+}
+
 func (u Controller) Delete(ctx context.Context, userID uuid.UUID) error {
 	if err := u.storage.Delete(ctx, userID); err != nil {
 		return fmt.Errorf("delete user: %w", err)
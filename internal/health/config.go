@@ -0,0 +1,41 @@
+package health
+
+import (
+	"time"
+
+	httpserver "github.com/yolkhovyy/go-userv/internal/server/http"
+)
+
+type Config struct {
+	// Timeout bounds each check.
+	Timeout time.Duration `yaml:"timeout" mapstructure:"Timeout"`
+	// CacheTTL reuses the last report of the checks, 0 runs them on every call.
+	CacheTTL time.Duration `yaml:"cacheTTL" mapstructure:"CacheTTL"`
+	// Server serves the checks on their own port, for services without
+	// a public HTTP port.
+	Server ServerConfig `yaml:"server" mapstructure:"Server"`
+}
+
+type ServerConfig struct {
+	Enable            bool `yaml:"enable" mapstructure:"Enable"`
+	httpserver.Config `yaml:",inline" mapstructure:",squash"`
+}
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"Health.Timeout":                  DefaultTimeout,
+		"Health.CacheTTL":                 DefaultCacheTTL,
+		"Health.Server.Enable":            false,
+		"Health.Server.Port":              DefaultServerPort,
+		"Health.Server.ShutdownTimeout":   httpserver.DefaultShutdownTimeout,
+		"Health.Server.ReadHeaderTimeout": httpserver.DefaultReadHeaderTimeout,
+		"Health.Server.IdleTimeout":       httpserver.DefaultIdleTimeout,
+		"Health.Server.MaxHeaderBytes":    httpserver.DefaultMaxHeaderBytes,
+	}
+}
+
+const (
+	DefaultTimeout    = 2 * time.Second
+	DefaultCacheTTL   = time.Second
+	DefaultServerPort = 8081
+)
@@ -0,0 +1,5 @@
+package health
+
+import "errors"
+
+var ErrDraining = errors.New("draining")
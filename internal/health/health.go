@@ -0,0 +1,264 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	chealth "github.com/yolkhovyy/go-userv/internal/contract/health"
+)
+
+// Check statuses.
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Endpoints served by Handler.
+const (
+	LivenessPath  = "/livez"
+	ReadinessPath = "/readyz"
+)
+
+// Report is the outcome of the liveness or readiness checks, the status is up
+// when all checks are up.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckReport `json:"checks,omitempty"`
+}
+
+type CheckReport struct {
+	Status  string `json:"status"`
+	Latency string `json:"latency,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
+
+// Summary keeps the status of the report and of its checks, without errors,
+// latencies or details, for endpoints served publicly.
+func (r Report) Summary() Report {
+	summary := Report{
+		Status: r.Status,
+		Checks: make(map[string]CheckReport, len(r.Checks)),
+	}
+
+	for name, check := range r.Checks {
+		summary.Checks[name] = CheckReport{Status: check.Status}
+	}
+
+	return summary
+}
+
+// CheckerFunc adapts a function to a Checker.
+type CheckerFunc func(ctx context.Context) (any, error)
+
+func (f CheckerFunc) Check(ctx context.Context) (any, error) {
+	return f(ctx)
+}
+
+// Registry keeps the liveness and readiness checks of a service.
+// Liveness tells whether the process should be restarted, readiness
+// whether it should receive traffic.
+type Registry struct {
+	config     Config
+	mutex      sync.RWMutex
+	liveness   map[string]chealth.Checker
+	ready      map[string]chealth.Checker
+	draining   atomic.Bool
+	liveCache  reportCache
+	readyCache reportCache
+}
+
+// reportCache keeps a report for the configured cache TTL, so that frequent
+// probes do not reach the dependencies on every call.
+type reportCache struct {
+	mutex    sync.Mutex
+	report   Report
+	expires  time.Time
+	draining bool
+}
+
+func (c *reportCache) invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.expires = time.Time{}
+}
+
+func New(config Config) *Registry {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	registry := &Registry{
+		config:   config,
+		liveness: make(map[string]chealth.Checker),
+		ready:    make(map[string]chealth.Checker),
+	}
+
+	registry.AddReadiness("draining", CheckerFunc(registry.checkDraining))
+
+	return registry
+}
+
+// AddLiveness adds a liveness check, it replaces a check with the same name.
+func (r *Registry) AddLiveness(name string, checker chealth.Checker) {
+	r.mutex.Lock()
+	r.liveness[name] = checker
+	r.mutex.Unlock()
+
+	r.liveCache.invalidate()
+}
+
+// AddReadiness adds a readiness check, it replaces a check with the same name.
+func (r *Registry) AddReadiness(name string, checker chealth.Checker) {
+	r.mutex.Lock()
+	r.ready[name] = checker
+	r.mutex.Unlock()
+
+	r.readyCache.invalidate()
+}
+
+// Drain fails readiness, so that load balancers stop sending traffic during shutdown.
+func (r *Registry) Drain() {
+	r.draining.Store(true)
+}
+
+// Liveness runs the liveness checks, or returns their report cached within the TTL.
+func (r *Registry) Liveness(ctx context.Context) Report {
+	return r.cached(ctx, &r.liveCache, r.liveness)
+}
+
+// Readiness runs the readiness checks, or returns their report cached within the TTL.
+func (r *Registry) Readiness(ctx context.Context) Report {
+	return r.cached(ctx, &r.readyCache, r.ready)
+}
+
+// cached runs checkers once per cache TTL, concurrent calls wait for the running checks.
+// A report cached before draining started is not reused.
+func (r *Registry) cached(ctx context.Context, cache *reportCache, checkers map[string]chealth.Checker) Report {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	draining := r.draining.Load()
+	if cache.draining == draining && time.Now().Before(cache.expires) {
+		return cache.report
+	}
+
+	r.mutex.RLock()
+	report := r.run(ctx, checkers)
+	r.mutex.RUnlock()
+
+	cache.report = report
+	cache.expires = time.Now().Add(r.config.CacheTTL)
+	cache.draining = draining
+
+	return report
+}
+
+// Handler serves the liveness and readiness reports, with 503 when a check is down.
+func (r *Registry) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+LivenessPath, r.ServeLiveness)
+	mux.HandleFunc("GET "+ReadinessPath, r.ServeReadiness)
+
+	return mux
+}
+
+func (r *Registry) ServeLiveness(writer http.ResponseWriter, request *http.Request) {
+	writeReport(writer, r.Liveness(request.Context()))
+}
+
+func (r *Registry) ServeReadiness(writer http.ResponseWriter, request *http.Request) {
+	writeReport(writer, r.Readiness(request.Context()))
+}
+
+// ServeLivenessSummary serves the summary of the liveness report, for public routers.
+func (r *Registry) ServeLivenessSummary(writer http.ResponseWriter, request *http.Request) {
+	writeReport(writer, r.Liveness(request.Context()).Summary())
+}
+
+// ServeReadinessSummary serves the summary of the readiness report, for public routers.
+func (r *Registry) ServeReadinessSummary(writer http.ResponseWriter, request *http.Request) {
+	writeReport(writer, r.Readiness(request.Context()).Summary())
+}
+
+// run runs checks concurrently, each bounded by the configured timeout.
+func (r *Registry) run(ctx context.Context, checkers map[string]chealth.Checker) Report {
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]CheckReport, len(checkers)),
+	}
+
+	var (
+		mutex sync.Mutex
+		group sync.WaitGroup
+	)
+
+	for name, checker := range checkers {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			check := r.check(ctx, checker)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			report.Checks[name] = check
+			if check.Status != StatusUp {
+				report.Status = StatusDown
+			}
+		}()
+	}
+
+	group.Wait()
+
+	return report
+}
+
+func (r *Registry) check(ctx context.Context, checker chealth.Checker) CheckReport {
+	ctx, cancel := context.WithTimeout(ctx, r.config.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	details, err := checker.Check(ctx)
+
+	report := CheckReport{
+		Status:  StatusUp,
+		Latency: time.Since(start).String(),
+		Details: details,
+	}
+
+	if err != nil {
+		report.Status = StatusDown
+		report.Error = err.Error()
+	}
+
+	return report
+}
+
+func (r *Registry) checkDraining(context.Context) (any, error) {
+	if r.draining.Load() {
+		return nil, ErrDraining
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func writeReport(writer http.ResponseWriter, report Report) {
+	status := http.StatusOK
+	if report.Status != StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(report)
+}
@@ -0,0 +1,121 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	registry := New(Config{Timeout: 10 * time.Millisecond})
+
+	registry.AddReadiness("postgres", CheckerFunc(func(context.Context) (any, error) {
+		return map[string]int{"idle": 2}, nil
+	}))
+
+	report := registry.Readiness(ctx)
+	assert.Equal(t, StatusUp, report.Status)
+	assert.Equal(t, StatusUp, report.Checks["draining"].Status)
+	assert.Equal(t, map[string]int{"idle": 2}, report.Checks["postgres"].Details)
+	assert.NotEmpty(t, report.Checks["postgres"].Latency)
+
+	// A failing check takes the report down, slow checks time out.
+	registry.AddReadiness("kafka", CheckerFunc(func(context.Context) (any, error) {
+		return nil, errors.New("connection refused") //nolint:err113
+	}))
+	registry.AddReadiness("slow", CheckerFunc(func(ctx context.Context) (any, error) {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}))
+
+	report = registry.Readiness(ctx)
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, "connection refused", report.Checks["kafka"].Error)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["slow"].Error)
+	assert.Equal(t, StatusUp, report.Checks["postgres"].Status)
+
+	// Liveness does not depend on readiness checks.
+	assert.Equal(t, Report{Status: StatusUp, Checks: map[string]CheckReport{}}, registry.Liveness(ctx))
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	t.Parallel()
+
+	registry := New(Config{})
+
+	get := func(target string) (int, Report) {
+		recorder := httptest.NewRecorder()
+		registry.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+		var report Report
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &report))
+
+		return recorder.Code, report
+	}
+
+	code, report := get(ReadinessPath)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusUp, report.Status)
+
+	// Draining fails readiness only.
+	registry.Drain()
+
+	code, report = get(ReadinessPath)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, ErrDraining.Error(), report.Checks["draining"].Error)
+
+	code, _ = get(LivenessPath)
+	assert.Equal(t, http.StatusOK, code)
+}
+
+func TestRegistry_Cache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	registry := New(Config{CacheTTL: time.Hour})
+
+	var calls int
+
+	registry.AddReadiness("postgres", CheckerFunc(func(context.Context) (any, error) {
+		calls++
+
+		return nil, nil //nolint:nilnil
+	}))
+
+	assert.Equal(t, StatusUp, registry.Readiness(ctx).Status)
+	assert.Equal(t, StatusUp, registry.Readiness(ctx).Status)
+	assert.Equal(t, 1, calls)
+
+	// Draining is reported although the report is cached.
+	registry.Drain()
+
+	assert.Equal(t, StatusDown, registry.Readiness(ctx).Status)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRegistry_Summary(t *testing.T) {
+	t.Parallel()
+
+	registry := New(Config{})
+	registry.AddReadiness("postgres", CheckerFunc(func(context.Context) (any, error) {
+		return map[string]int{"idle": 2}, errors.New("dial tcp 10.0.0.5:5432: connection refused") //nolint:err113
+	}))
+
+	recorder := httptest.NewRecorder()
+	registry.ServeReadinessSummary(recorder, httptest.NewRequest(http.MethodGet, ReadinessPath, nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.JSONEq(t, `{"status":"down","checks":{"draining":{"status":"up"},"postgres":{"status":"down"}}}`,
+		recorder.Body.String())
+}
@@ -0,0 +1,33 @@
+package idempotency
+
+import "time"
+
+type Config struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// TTL is how long responses are kept for replay.
+	TTL time.Duration `yaml:"ttl" mapstructure:"TTL"`
+	// LockTimeout releases keys of requests that did not complete, e.g. after a crash.
+	LockTimeout time.Duration `yaml:"lockTimeout" mapstructure:"LockTimeout"`
+	// Store keeps the keys: postgres, shared by instances, or memory, local to an instance.
+	Store string `yaml:"store" mapstructure:"Store"`
+}
+
+// Stores.
+const (
+	StorePostgres = "postgres"
+	StoreMemory   = "memory"
+)
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"Idempotency.Enable":      true,
+		"Idempotency.TTL":         DefaultTTL,
+		"Idempotency.LockTimeout": DefaultLockTimeout,
+		"Idempotency.Store":       StorePostgres,
+	}
+}
+
+const (
+	DefaultTTL         = 24 * time.Hour
+	DefaultLockTimeout = time.Minute
+)
@@ -0,0 +1,10 @@
+package idempotency
+
+import "errors"
+
+var (
+	ErrKeyReused    = errors.New("idempotency key reused with a different request")
+	ErrInProgress   = errors.New("idempotency key request in progress")
+	ErrInvalidKey   = errors.New("invalid idempotency key")
+	ErrInvalidStore = errors.New("invalid idempotency store")
+)
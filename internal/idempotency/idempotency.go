@@ -0,0 +1,63 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// Idempotency keys let clients retry requests with side effects, see
+// https://datatracker.ietf.org/doc/draft-ietf-httpapi-idempotency-key-header.
+
+// MaxKeyLength is the maximal length of a key.
+const MaxKeyLength = 255
+
+// Store keeps the responses of requests by idempotency key.
+type Store interface {
+	// Begin claims key for a request with fingerprint. It returns the stored response
+	// when a request with the same key and fingerprint completed, ErrKeyReused when
+	// the fingerprints differ and ErrInProgress when the request did not complete yet.
+	Begin(ctx context.Context, key, fingerprint string) (*Response, error)
+	// Complete stores the response of a claimed key.
+	Complete(ctx context.Context, key string, response Response) error
+	// Release removes the claim of a request which failed, so that it can be retried.
+	Release(ctx context.Context, key string) error
+	io.Closer
+}
+
+// Response is a stored response.
+type Response struct {
+	Status      int
+	ContentType string
+	Body        []byte
+}
+
+// ValidateKey checks the key length.
+func ValidateKey(key string) error {
+	if len(key) > MaxKeyLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidKey, MaxKeyLength)
+	}
+
+	return nil
+}
+
+// ClientKey scopes key to a client, so that clients sending the same key
+// do not get the responses of each other.
+func ClientKey(client, key string) string {
+	return Fingerprint([]byte(client), []byte(key))
+}
+
+// Fingerprint hashes the parts identifying a request, e.g. method, path and body.
+func Fingerprint(parts ...[]byte) string {
+	hash := sha256.New()
+
+	for _, part := range parts {
+		_ = binary.Write(hash, binary.BigEndian, uint64(len(part)))
+		_, _ = hash.Write(part)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,105 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the minimal interval between removals of expired keys.
+const sweepInterval = time.Minute
+
+// Memory is a Store local to an instance, expired keys are swept periodically on Begin.
+type Memory struct {
+	config  Config
+	mutex   sync.Mutex
+	entries map[string]*entry
+	swept   time.Time
+	now     func() time.Time
+}
+
+type entry struct {
+	fingerprint string
+	response    *Response
+	claimed     time.Time
+	expires     time.Time
+}
+
+func NewMemory(config Config) *Memory {
+	return &Memory{
+		config:  config,
+		entries: make(map[string]*entry),
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Begin(_ context.Context, key, fingerprint string) (*Response, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	existing, ok := m.entries[key]
+	if !ok || existing.expired(now, m.config.LockTimeout) {
+		m.entries[key] = &entry{fingerprint: fingerprint, claimed: now, expires: now.Add(m.config.TTL)}
+
+		return nil, nil
+	}
+
+	switch {
+	case existing.fingerprint != fingerprint:
+		return nil, ErrKeyReused
+	case existing.response == nil:
+		return nil, ErrInProgress
+	}
+
+	response := *existing.response
+
+	return &response, nil
+}
+
+func (m *Memory) Complete(_ context.Context, key string, response Response) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if existing, ok := m.entries[key]; ok {
+		existing.response = &response
+	}
+
+	return nil
+}
+
+func (m *Memory) Release(_ context.Context, key string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if existing, ok := m.entries[key]; ok && existing.response == nil {
+		delete(m.entries, key)
+	}
+
+	return nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+// sweep removes expired keys.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.swept) < sweepInterval {
+		return
+	}
+
+	m.swept = now
+
+	for key, e := range m.entries {
+		if e.expired(now, m.config.LockTimeout) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+func (e *entry) expired(now time.Time, lockTimeout time.Duration) bool {
+	return now.After(e.expires) || (e.response == nil && now.After(e.claimed.Add(lockTimeout)))
+}
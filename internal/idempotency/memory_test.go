@@ -0,0 +1,98 @@
+package idempotency
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := NewMemory(Config{TTL: time.Hour, LockTimeout: time.Minute})
+	store.now = func() time.Time { return now }
+
+	response := Response{Status: 201, ContentType: "application/json", Body: []byte(`{"id":"42"}`)}
+	fingerprint := Fingerprint([]byte("POST"), []byte("/api/v1/user"), []byte(`{}`))
+
+	// First request claims the key.
+	stored, err := store.Begin(ctx, "key", fingerprint)
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+
+	// A concurrent retry waits for the first request.
+	_, err = store.Begin(ctx, "key", fingerprint)
+	require.ErrorIs(t, err, ErrInProgress)
+
+	require.NoError(t, store.Complete(ctx, "key", response))
+
+	// A retry gets the stored response, a different request an error.
+	stored, err = store.Begin(ctx, "key", fingerprint)
+	require.NoError(t, err)
+	assert.Equal(t, &response, stored)
+
+	_, err = store.Begin(ctx, "key", Fingerprint([]byte("POST"), []byte("/api/v1/user"), []byte(`{"a":1}`)))
+	require.ErrorIs(t, err, ErrKeyReused)
+
+	// The key is reusable after the TTL.
+	now = now.Add(2 * time.Hour)
+
+	stored, err = store.Begin(ctx, "key", "other")
+	require.NoError(t, err)
+	assert.Nil(t, stored)
+
+	// Released and timed out claims can be retried.
+	require.NoError(t, store.Release(ctx, "key"))
+
+	_, err = store.Begin(ctx, "key", fingerprint)
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+
+	_, err = store.Begin(ctx, "key", fingerprint)
+	require.NoError(t, err)
+}
+
+func TestMemory_Sweep(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := NewMemory(Config{TTL: time.Hour, LockTimeout: time.Minute})
+	store.now = func() time.Time { return now }
+
+	_, err := store.Begin(ctx, "expired", "fingerprint")
+	require.NoError(t, err)
+	require.NoError(t, store.Complete(ctx, "expired", Response{Status: 201}))
+
+	// Expired keys are kept until the next sweep.
+	now = now.Add(2 * time.Hour)
+
+	_, err = store.Begin(ctx, "live", "fingerprint")
+	require.NoError(t, err)
+	assert.Len(t, store.entries, 1)
+
+	now = now.Add(30 * time.Second)
+
+	_, err = store.Begin(ctx, "other", "fingerprint")
+	require.NoError(t, err)
+	assert.Len(t, store.entries, 2)
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEqual(t, Fingerprint([]byte("ab"), []byte("c")), Fingerprint([]byte("a"), []byte("bc")))
+	assert.Len(t, Fingerprint(), 64)
+
+	require.NoError(t, ValidateKey(strings.Repeat("k", MaxKeyLength)))
+	require.ErrorIs(t, ValidateKey(strings.Repeat("k", MaxKeyLength+1)), ErrInvalidKey)
+}
@@ -0,0 +1,139 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
+)
+
+// Postgres is a Store shared by service instances, keys are kept in the
+// idempotency_keys table, see config/postgres/init.sql.
+type Postgres struct {
+	config Config
+	pool   *pgxpool.Pool
+	purged atomic.Int64
+}
+
+// purgeInterval is the minimal interval between removals of expired keys.
+const purgeInterval = time.Hour
+
+func NewPostgres(ctx context.Context, config Config, postgresConfig postgres.Config) (*Postgres, error) {
+	pool, err := postgres.NewPool(ctx, postgresConfig)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency store: %w", err)
+	}
+
+	return &Postgres{config: config, pool: pool}, nil
+}
+
+func (p *Postgres) Begin(ctx context.Context, key, fingerprint string) (*Response, error) {
+	p.purge(ctx)
+
+	// Claim the key, or reclaim it when expired or left incomplete.
+	query := `
+		INSERT INTO idempotency_keys (key, fingerprint, created_at, expires_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + make_interval(secs => $3))
+		ON CONFLICT (key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			status_code = NULL,
+			content_type = NULL,
+			body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at < CURRENT_TIMESTAMP
+			OR (idempotency_keys.status_code IS NULL
+				AND idempotency_keys.created_at < CURRENT_TIMESTAMP - make_interval(secs => $4))`
+
+	tag, err := p.pool.Exec(ctx, query, key, fingerprint,
+		p.config.TTL.Seconds(), p.config.LockTimeout.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("claim idempotency key: %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, nil
+	}
+
+	var (
+		storedFingerprint string
+		status            *int
+		contentType       *string
+		body              []byte
+	)
+
+	query = `SELECT fingerprint, status_code, content_type, body FROM idempotency_keys WHERE key = $1`
+
+	err = p.pool.QueryRow(ctx, query, key).
+		Scan(&storedFingerprint, &status, &contentType, &body)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		// Removed since the claim failed, the client may retry.
+		return nil, ErrInProgress
+	case err != nil:
+		return nil, fmt.Errorf("get idempotency key: %w", err)
+	case storedFingerprint != fingerprint:
+		return nil, ErrKeyReused
+	case status == nil:
+		return nil, ErrInProgress
+	}
+
+	response := Response{Status: *status, Body: body}
+	if contentType != nil {
+		response.ContentType = *contentType
+	}
+
+	return &response, nil
+}
+
+func (p *Postgres) Complete(ctx context.Context, key string, response Response) error {
+	query := `UPDATE idempotency_keys SET status_code = $2, content_type = $3, body = $4 WHERE key = $1`
+
+	if _, err := p.pool.Exec(ctx, query, key, response.Status, response.ContentType, response.Body); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) Release(ctx context.Context, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE key = $1 AND status_code IS NULL`
+
+	if _, err := p.pool.Exec(ctx, query, key); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// purge removes expired keys, at most once per purge interval.
+func (p *Postgres) purge(ctx context.Context) {
+	now := time.Now().UnixNano()
+
+	last := p.purged.Load()
+	if now-last < int64(purgeInterval) || !p.purged.CompareAndSwap(last, now) {
+		return
+	}
+
+	query := `DELETE FROM idempotency_keys WHERE expires_at < CURRENT_TIMESTAMP`
+
+	if _, err := p.pool.Exec(ctx, query); err != nil {
+		slogw.DefaultLogger().ErrorContext(ctx, "idempotency",
+			slog.String("purge", err.Error()),
+		)
+	}
+}
+
+func (p *Postgres) Close() error {
+	p.pool.Close()
+
+	return nil
+}
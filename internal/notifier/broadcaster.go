@@ -0,0 +1,132 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/lib/pq"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/storage/postgres"
+)
+
+// Broadcaster fans out user changes captured from storage to in-process
+// subscribers. Events are dropped for subscribers that do not keep up.
+type Broadcaster struct {
+	pqListener  *pq.Listener
+	mutex       sync.Mutex
+	subscribers map[chan domain.UserEvent]struct{}
+}
+
+func NewBroadcaster(config postgres.Config) (*Broadcaster, error) {
+	listener, err := Connect(config)
+	if err != nil {
+		return nil, fmt.Errorf("storage listener: %w", err)
+	}
+
+	slogw.DefaultLogger().Debug("broadcaster connected to database")
+
+	return &Broadcaster{
+		pqListener:  listener.Listener,
+		subscribers: make(map[chan domain.UserEvent]struct{}),
+	}, nil
+}
+
+// Subscribe returns a channel of user events, closed when ctx is done.
+func (b *Broadcaster) Subscribe(ctx context.Context) <-chan domain.UserEvent {
+	const bufferSize = 64
+
+	events := make(chan domain.UserEvent, bufferSize)
+
+	b.mutex.Lock()
+	b.subscribers[events] = struct{}{}
+	b.mutex.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mutex.Lock()
+		defer b.mutex.Unlock()
+
+		if _, ok := b.subscribers[events]; ok {
+			delete(b.subscribers, events)
+			close(events)
+		}
+	}()
+
+	return events
+}
+
+func (b *Broadcaster) Run(ctx context.Context) error {
+	logger := slogw.DefaultLogger()
+
+	if err := b.pqListener.Listen(userChangesChannel); err != nil {
+		return fmt.Errorf("broadcaster listen: %w", err)
+	}
+
+	defer func() {
+		b.closeSubscribers()
+
+		if err := b.pqListener.Close(); err != nil {
+			logger.ErrorContext(ctx, "broadcaster",
+				slog.String("listener close", err.Error()))
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if !errors.Is(ctx.Err(), context.Canceled) {
+				return fmt.Errorf("broadcaster loop: %w", ctx.Err())
+			}
+
+			logger.DebugContext(ctx, "broadcaster",
+				slog.String("listener exiting", ctx.Err().Error()))
+
+			return nil
+
+		case notification := <-b.pqListener.Notify:
+			if notification == nil {
+				continue
+			}
+
+			var event domain.UserEvent
+			if err := json.Unmarshal([]byte(notification.Extra), &event); err != nil {
+				logger.ErrorContext(ctx, "broadcaster",
+					slog.String("event unmarshal", err.Error()))
+
+				continue
+			}
+
+			b.broadcast(ctx, event)
+		}
+	}
+}
+
+func (b *Broadcaster) broadcast(ctx context.Context, event domain.UserEvent) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for events := range b.subscribers {
+		select {
+		case events <- event:
+		default:
+			slogw.DefaultLogger().WarnContext(ctx, "broadcaster",
+				slog.String("dropped event", event.ID.String()))
+		}
+	}
+}
+
+func (b *Broadcaster) closeSubscribers() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for events := range b.subscribers {
+		delete(b.subscribers, events)
+		close(events)
+	}
+}
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrListenerConnectFailed = errors.New("listener connect failed")
 	ErrNilListener           = errors.New("nil listener")
+	ErrNoBrokers             = errors.New("no kafka brokers")
 )
@@ -0,0 +1,39 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+	chealth "github.com/yolkhovyy/go-userv/internal/contract/health"
+	"github.com/yolkhovyy/go-userv/internal/health"
+)
+
+// KafkaChecker checks that Kafka is reachable, at least one broker must accept a connection.
+//
+//nolint:ireturn
+func KafkaChecker(config Config) chealth.Checker {
+	return health.CheckerFunc(func(ctx context.Context) (any, error) {
+		if len(config.Brokers) == 0 {
+			return nil, ErrNoBrokers
+		}
+
+		var errs []error
+
+		for _, broker := range config.Brokers {
+			conn, err := (&kafka.Dialer{}).DialContext(ctx, "tcp", broker)
+			if err != nil {
+				errs = append(errs, err)
+
+				continue
+			}
+
+			_ = conn.Close()
+
+			return map[string]string{"broker": broker}, nil
+		}
+
+		return nil, fmt.Errorf("kafka dial: %w", errors.Join(errs...))
+	})
+}
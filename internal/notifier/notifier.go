@@ -2,6 +2,7 @@ package notifier
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -9,36 +10,44 @@ import (
 	"github.com/segmentio/kafka-go"
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
 	"github.com/yolkhovyy/go-otelw/otelw/tracew"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/semaphore"
 )
 
+// userChangesChannel is notified by the users table trigger.
+const userChangesChannel = "user_changes"
+
 //nolint:funlen,cyclop
 func (c *Controller) Run(ctx context.Context) error {
 	// TODO: Inspect hard-coded values.
 	const (
 		topic     = "postgres.public.users"
 		key       = "user-event"
-		channel   = "user_changes"
 		rateLimit = 500
 	)
 
 	logger := slogw.DefaultLogger()
 
-	err := c.pqListener.Listen(channel)
-	if err != nil {
-		return fmt.Errorf("notifier listen: %w", err)
-	}
-
 	defer func() {
+		if err := c.pqListener.Close(); err != nil {
+			logger.ErrorContext(ctx, "notifier",
+				slog.String("listener close", err.Error()))
+		}
+
 		if err := c.kafkaWriter.Close(); err != nil {
 			logger.ErrorContext(ctx, "notifier",
 				slog.String("kafka writer close", err.Error()))
 		}
 	}()
 
+	err := c.pqListener.Listen(userChangesChannel)
+	if err != nil {
+		return fmt.Errorf("notifier listen: %w", err)
+	}
+
 	rateLimiter := semaphore.NewWeighted(rateLimit)
 
 	for {
@@ -64,6 +73,8 @@ func (c *Controller) Run(ctx context.Context) error {
 			go func() {
 				var err error
 
+				ctx := notificationContext(ctx, notification.Extra)
+
 				ctx, span := tracew.Start(ctx, "listener", "notify")
 				defer func() { span.End(err) }()
 
@@ -86,6 +97,10 @@ func (c *Controller) Run(ctx context.Context) error {
 				headerCarrier := &KafkaHeaderCarrier{Headers: []kafka.Header{}}
 				otel.GetTextMapPropagator().Inject(ctx, headerCarrier)
 
+				if id := requestid.FromContext(ctx); id != "" {
+					headerCarrier.Set(requestid.Header, id)
+				}
+
 				err = c.kafkaWriter.WriteMessages(ctx, kafka.Message{
 					Topic:   topic,
 					Key:     []byte(key),
@@ -98,12 +113,28 @@ func (c *Controller) Run(ctx context.Context) error {
 					logger.WarnContext(ctx, "notifier listener exiting, context cancelled")
 				case err != nil:
 					logger.ErrorContext(ctx, "notifier",
-						slog.String("listener", err.Error()))
+						slog.String("listener", err.Error()),
+						requestid.Attr(ctx))
 				default:
 					logger.InfoContext(ctx, "notifier",
-						slog.Any("notification extra", notification.Extra))
+						slog.Any("notification extra", notification.Extra),
+						requestid.Attr(ctx))
 				}
 			}()
 		}
 	}
 }
+
+// notificationContext returns ctx carrying the request id of the change, the
+// users trigger adds it to the notification payload when it is known.
+func notificationContext(ctx context.Context, payload string) context.Context {
+	var notification struct {
+		RequestID string `json:"requestId"`
+	}
+
+	if err := json.Unmarshal([]byte(payload), &notification); err != nil || !requestid.Valid(notification.RequestID) {
+		return ctx
+	}
+
+	return requestid.NewContext(ctx, notification.RequestID)
+}
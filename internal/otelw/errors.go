@@ -0,0 +1,5 @@
+package otelw
+
+import "errors"
+
+var ErrInvalidLevel = errors.New("invalid log level")
@@ -0,0 +1,97 @@
+package otelw
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Levels are the log levels accepted by slogw.
+//
+//nolint:gochecknoglobals
+var Levels = []string{"trace", "debug", "info", "warn", "error", "fatal", "panic", "disabled"}
+
+// LogLevel changes the level of the default logger at runtime,
+// the logger is reconfigured with the new level.
+type LogLevel struct {
+	mutex    sync.Mutex
+	config   slogw.Config
+	attrs    []attribute.KeyValue
+	writers  []io.Writer
+	original *slogw.Logger
+	current  *slogw.Logger
+}
+
+// NewLogLevel controls the level of the logger configured with config, attrs and writers.
+func NewLogLevel(
+	config slogw.Config,
+	logger *slogw.Logger,
+	attrs []attribute.KeyValue,
+	writers ...io.Writer,
+) *LogLevel {
+	return &LogLevel{
+		config:   config,
+		attrs:    attrs,
+		writers:  writers,
+		original: logger,
+		current:  logger,
+	}
+}
+
+func (l *LogLevel) Level() string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.config.Level
+}
+
+// SetLevel reconfigures the logger with level.
+func (l *LogLevel) SetLevel(ctx context.Context, level string) error {
+	if !slices.Contains(Levels, level) {
+		return fmt.Errorf("%w: %s", ErrInvalidLevel, level)
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	config := l.config
+	config.Level = level
+
+	logger, err := slogw.Configure(ctx, config, l.attrs, l.writers...)
+	if err != nil {
+		return fmt.Errorf("slogw configure: %w", err)
+	}
+
+	previous := l.current
+	l.config, l.current = config, logger
+
+	// The original logger is shut down by its owner.
+	if previous != l.original {
+		if err := previous.Shutdown(ctx); err != nil {
+			return fmt.Errorf("slogw shutdown: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Shutdown shuts down the logger configured by SetLevel, if any.
+func (l *LogLevel) Shutdown(ctx context.Context) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.current == l.original {
+		return nil
+	}
+
+	if err := l.current.Shutdown(ctx); err != nil {
+		return fmt.Errorf("slogw shutdown: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package ratelimit
+
+import "time"
+
+type Config struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// Key identifies clients: ip or apiKey. Clients with an API key listed in APIKeys
+	// have a bucket of the key in addition to the bucket of their IP, other keys are ignored.
+	Key          string   `yaml:"key" mapstructure:"Key"`
+	APIKeyHeader string   `yaml:"apiKeyHeader" mapstructure:"APIKeyHeader"`
+	APIKeys      []string `yaml:"apiKeys" mapstructure:"APIKeys"`
+	// Rate is the number of requests per second refilling the bucket of a client,
+	// Burst the bucket size.
+	Rate  float64 `yaml:"rate" mapstructure:"Rate"`
+	Burst int     `yaml:"burst" mapstructure:"Burst"`
+	// Routes override the rate and burst of routes: "METHOD /path" in REST
+	// and GraphQL, the full method name in gRPC. Each route has its own bucket.
+	// GraphQL root fields listed as "Type.field", e.g. Mutation.create, take
+	// a token of their own bucket per call in addition to the request token.
+	Routes []RouteConfig `yaml:"routes" mapstructure:"Routes"`
+	// Exempt routes are not limited.
+	Exempt []string `yaml:"exempt" mapstructure:"Exempt"`
+	// Store keeps the buckets: memory, local to an instance, or redis, shared by instances.
+	Store string      `yaml:"store" mapstructure:"Store"`
+	Redis RedisConfig `yaml:"redis" mapstructure:"Redis"`
+}
+
+type RouteConfig struct {
+	Route string  `yaml:"route" mapstructure:"Route"`
+	Rate  float64 `yaml:"rate" mapstructure:"Rate"`
+	Burst int     `yaml:"burst" mapstructure:"Burst"`
+}
+
+type RedisConfig struct {
+	Address  string        `yaml:"address" mapstructure:"Address"`
+	Password string        `yaml:"password" mapstructure:"Password"`
+	DB       int           `yaml:"db" mapstructure:"DB"`
+	Prefix   string        `yaml:"prefix" mapstructure:"Prefix"`
+	Timeout  time.Duration `yaml:"timeout" mapstructure:"Timeout"`
+}
+
+// Client keys.
+const (
+	KeyIP     = "ip"
+	KeyAPIKey = "apiKey"
+)
+
+// Stores.
+const (
+	StoreMemory = "memory"
+	StoreRedis  = "redis"
+)
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"RateLimit.Enable":        true,
+		"RateLimit.Key":           KeyIP,
+		"RateLimit.APIKeyHeader":  DefaultAPIKeyHeader,
+		"RateLimit.Rate":          DefaultRate,
+		"RateLimit.Burst":         DefaultBurst,
+		"RateLimit.Exempt":        []string{"GET /health", "GET /livez", "GET /readyz", "GET /metrics"},
+		"RateLimit.Store":         StoreMemory,
+		"RateLimit.Redis.Address": DefaultRedisAddress,
+		"RateLimit.Redis.Prefix":  DefaultRedisPrefix,
+		"RateLimit.Redis.Timeout": DefaultRedisTimeout,
+	}
+}
+
+const (
+	DefaultAPIKeyHeader = "X-API-Key"
+	DefaultRate         = 10.0
+	DefaultBurst        = 20
+	DefaultRedisAddress = "localhost:6379"
+	DefaultRedisPrefix  = "ratelimit:"
+	DefaultRedisTimeout = 100 * time.Millisecond
+)
@@ -0,0 +1,12 @@
+package ratelimit
+
+import "errors"
+
+var (
+	ErrLimited      = errors.New("rate limited")
+	ErrInvalidKey   = errors.New("invalid rate limit key")
+	ErrInvalidStore = errors.New("invalid rate limit store")
+	ErrInvalidLimit = errors.New("invalid rate limit")
+	ErrInvalidProxy = errors.New("invalid trusted proxy")
+	ErrRedisReply   = errors.New("unexpected redis reply")
+)
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the minimal interval between removals of full buckets.
+const sweepInterval = time.Minute
+
+// Memory is a Store local to an instance.
+type Memory struct {
+	mutex   sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	state, ok := m.buckets[key]
+	if !ok {
+		state = &bucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = state
+	}
+
+	var result Result
+
+	state.tokens, result = take(state.tokens, now.Sub(state.updated), limit)
+	state.updated = now
+	state.full = now.Add(result.Reset)
+
+	return result, nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+// sweep removes full buckets, they are recreated full.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.swept) < sweepInterval {
+		return
+	}
+
+	m.swept = now
+
+	for key, state := range m.buckets {
+		if !now.Before(state.full) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+// take refills a bucket of tokens for elapsed and takes a token.
+func take(tokens float64, elapsed time.Duration, limit Limit) (float64, Result) {
+	tokens = math.Min(float64(limit.Burst), tokens+math.Max(0, elapsed.Seconds())*limit.Rate)
+
+	result := Result{Limit: limit.Burst}
+
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = rateDuration(1-tokens, limit.Rate)
+	}
+
+	result.Remaining = int(tokens)
+	result.Reset = rateDuration(float64(limit.Burst)-tokens, limit.Rate)
+
+	return tokens, result
+}
+
+// rateDuration is the time to refill tokens at rate.
+func rateDuration(tokens, rate float64) time.Duration {
+	return time.Duration(tokens / rate * float64(time.Second))
+}
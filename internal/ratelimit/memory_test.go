@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := NewMemory()
+	store.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 2, Burst: 3}
+
+	// The bucket starts full.
+	for remaining := 2; remaining >= 0; remaining-- {
+		result, err := store.Take(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 3, result.Limit)
+		assert.Equal(t, remaining, result.Remaining)
+	}
+
+	// An empty bucket gets a token after 1/rate.
+	result, err := store.Take(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, result.Reset)
+
+	now = now.Add(500 * time.Millisecond)
+
+	result, err = store.Take(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// Buckets are independent.
+	result, err = store.Take(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Remaining)
+
+	// Full buckets are swept.
+	now = now.Add(time.Hour)
+
+	_, err = store.Take(ctx, "key", limit)
+	require.NoError(t, err)
+	assert.Len(t, store.buckets, 1)
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	limiter, err := New(Config{
+		Key:          KeyAPIKey,
+		APIKeyHeader: DefaultAPIKeyHeader,
+		APIKeys:      []string{"alice"},
+		Rate:         1,
+		Burst:        2,
+		Routes:       []RouteConfig{{Route: "POST /api/v1/user", Rate: 1, Burst: 1}},
+		Exempt:       []string{"GET /health"},
+	})
+	require.NoError(t, err)
+
+	defer limiter.Close()
+
+	allow := func(route string, client Client) Result {
+		t.Helper()
+
+		result, err := limiter.Allow(ctx, route, client)
+		require.NoError(t, err)
+
+		return result
+	}
+
+	alice := Client{IP: "10.0.0.1", APIKey: "alice"}
+
+	// Routes without an override share a bucket.
+	assert.True(t, allow("GET /api/v1/users", alice).Allowed)
+	assert.True(t, allow("GET /api/v1/user/:id", alice).Allowed)
+	assert.False(t, allow("GET /api/v1/users", alice).Allowed)
+
+	// Overridden routes have their own bucket.
+	assert.True(t, allow("POST /api/v1/user", alice).Allowed)
+	assert.False(t, allow("POST /api/v1/user", alice).Allowed)
+
+	// Exempt routes are not limited.
+	assert.Equal(t, Result{Allowed: true}, allow("GET /health", alice))
+
+	// Unknown API keys do not get a bucket, the IP limit applies.
+	assert.False(t, allow("GET /api/v1/users", Client{IP: "10.0.0.1", APIKey: "mallory"}).Allowed)
+
+	// Known API keys are limited across IPs.
+	assert.False(t, allow("GET /api/v1/users", Client{IP: "10.0.0.2", APIKey: "alice"}).Allowed)
+	assert.True(t, allow("GET /api/v1/users", Client{IP: "10.0.0.2"}).Allowed)
+
+	// Clients are identified by known API keys, by IP otherwise.
+	assert.Equal(t, limiter.ClientID(Client{IP: "10.0.0.2", APIKey: "alice"}), limiter.ClientID(alice))
+	assert.Equal(t, "ip:10.0.0.1", limiter.ClientID(Client{IP: "10.0.0.1", APIKey: "mallory"}))
+	assert.Equal(t, "ip:10.0.0.1", (*Limiter)(nil).ClientID(alice))
+}
+
+func TestNew_Errors(t *testing.T) {
+	t.Parallel()
+
+	for name, test := range map[string]struct {
+		config Config
+		err    error
+	}{
+		"key":     {Config{Key: "cookie", Rate: 1, Burst: 1}, ErrInvalidKey},
+		"apiKeys": {Config{Key: KeyAPIKey, Rate: 1, Burst: 1}, ErrInvalidKey},
+		"store":   {Config{Key: KeyIP, Rate: 1, Burst: 1, Store: "etcd"}, ErrInvalidStore},
+		"rate":    {Config{Key: KeyIP, Burst: 1}, ErrInvalidLimit},
+		"route":   {Config{Key: KeyIP, Rate: 1, Burst: 1, Routes: []RouteConfig{{Route: "GET /", Rate: 1}}}, ErrInvalidLimit},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(test.config)
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestSetHeaders(t *testing.T) {
+	t.Parallel()
+
+	header := http.Header{}
+	SetHeaders(header, Result{Limit: 20, Remaining: 0, RetryAfter: 1100 * time.Millisecond, Reset: 10 * time.Second})
+
+	assert.Equal(t, "20", header.Get("RateLimit-Limit"))
+	assert.Equal(t, "0", header.Get("RateLimit-Remaining"))
+	assert.Equal(t, "10", header.Get("RateLimit-Reset"))
+	assert.Equal(t, "2", header.Get("Retry-After"))
+
+	header = http.Header{}
+	SetHeaders(header, Result{Allowed: true})
+	assert.Empty(t, header)
+}
@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Proxies are the trusted reverse proxies, the X-Forwarded-For header
+// is only honoured on requests coming from them.
+type Proxies []netip.Prefix
+
+// ParseProxies parses IP addresses and CIDR ranges of trusted proxies.
+func ParseProxies(proxies []string) (Proxies, error) {
+	prefixes := make(Proxies, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		if strings.Contains(proxy, "/") {
+			prefix, err := netip.ParsePrefix(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidProxy, proxy)
+			}
+
+			prefixes = append(prefixes, prefix.Masked())
+
+			continue
+		}
+
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidProxy, proxy)
+		}
+
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+
+	return prefixes, nil
+}
+
+// ClientIP returns the IP of the client of request. When the request comes from
+// a trusted proxy, X-Forwarded-For is walked from the right and the first address
+// not of a trusted proxy is the client.
+func (p Proxies) ClientIP(request *http.Request) string {
+	ip := HostIP(request.RemoteAddr)
+	if !p.trusted(ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(request.Header.Values("X-Forwarded-For"), ","), ",")
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		address := strings.TrimSpace(forwarded[i])
+		if _, err := netip.ParseAddr(address); err != nil {
+			break
+		}
+
+		ip = address
+
+		if !p.trusted(ip) {
+			break
+		}
+	}
+
+	return ip
+}
+
+func (p Proxies) trusted(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxies_ClientIP(t *testing.T) {
+	t.Parallel()
+
+	proxies, err := ParseProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+
+	for name, test := range map[string]struct {
+		proxies   Proxies
+		remote    string
+		forwarded []string
+		expected  string
+	}{
+		"untrusted remote":   {proxies, "203.0.113.7:4242", []string{"198.51.100.1"}, "203.0.113.7"},
+		"no trusted proxies": {nil, "10.0.0.1:4242", []string{"198.51.100.1"}, "10.0.0.1"},
+		"trusted proxy":      {proxies, "10.0.0.1:4242", []string{"198.51.100.1"}, "198.51.100.1"},
+		"spoofed chain": {
+			proxies, "10.0.0.1:4242", []string{"1.2.3.4, 198.51.100.1", "192.168.1.1"}, "198.51.100.1",
+		},
+		"only proxies":      {proxies, "10.0.0.1:4242", []string{"10.0.0.2"}, "10.0.0.2"},
+		"invalid forwarded": {proxies, "10.0.0.1:4242", []string{"garbage"}, "10.0.0.1"},
+		"no forwarded":      {proxies, "192.168.1.1:4242", nil, "192.168.1.1"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.RemoteAddr = test.remote
+
+			for _, forwarded := range test.forwarded {
+				request.Header.Add("X-Forwarded-For", forwarded)
+			}
+
+			assert.Equal(t, test.expected, test.proxies.ClientIP(request))
+		})
+	}
+
+	_, err = ParseProxies([]string{"10.0.0.0/33"})
+	require.ErrorIs(t, err, ErrInvalidProxy)
+}
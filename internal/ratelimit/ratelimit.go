@@ -0,0 +1,221 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Token bucket rate limiting, each client has a bucket of Burst tokens
+// refilled at Rate tokens per second, a request takes a token. Clients
+// are identified by IP, clients with a known API key also by the key.
+
+// Limit is the rate and burst of a bucket.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result is the bucket state after a request.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// RetryAfter is the time until a token is available, zero when allowed.
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is full.
+	Reset time.Duration
+}
+
+// Store keeps the token buckets.
+type Store interface {
+	// Take takes a token from the bucket of key.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	io.Closer
+}
+
+// Limiter limits the requests of clients per route.
+type Limiter struct {
+	config  Config
+	store   Store
+	routes  map[string]Limit
+	apiKeys map[string]bool
+}
+
+// New creates a limiter with the store of the config.
+func New(config Config) (*Limiter, error) {
+	var store Store
+
+	switch config.Store {
+	case StoreMemory, "":
+		store = NewMemory()
+	case StoreRedis:
+		store = NewRedis(config.Redis)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStore, config.Store)
+	}
+
+	return NewWithStore(config, store)
+}
+
+// NewWithStore creates a limiter with a store.
+func NewWithStore(config Config, store Store) (*Limiter, error) {
+	switch config.Key {
+	case KeyIP:
+	case KeyAPIKey:
+		if len(config.APIKeys) == 0 {
+			return nil, fmt.Errorf("%w: %s without API keys", ErrInvalidKey, config.Key)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidKey, config.Key)
+	}
+
+	if config.Rate <= 0 || config.Burst < 1 {
+		return nil, fmt.Errorf("%w: rate %v, burst %d", ErrInvalidLimit, config.Rate, config.Burst)
+	}
+
+	routes := make(map[string]Limit, len(config.Routes))
+
+	for _, route := range config.Routes {
+		if route.Rate <= 0 || route.Burst < 1 {
+			return nil, fmt.Errorf("%w: %s rate %v, burst %d", ErrInvalidLimit, route.Route, route.Rate, route.Burst)
+		}
+
+		routes[route.Route] = Limit{Rate: route.Rate, Burst: route.Burst}
+	}
+
+	apiKeys := make(map[string]bool, len(config.APIKeys))
+	for _, apiKey := range config.APIKeys {
+		apiKeys[hashAPIKey(apiKey)] = true
+	}
+
+	return &Limiter{config: config, store: store, routes: routes, apiKeys: apiKeys}, nil
+}
+
+// Client identifies the client of a request.
+type Client struct {
+	IP     string
+	APIKey string
+}
+
+// Allow takes a token for a request of client to route from the bucket of the
+// client IP, then from the bucket of a known API key. Exempt routes are allowed
+// without a limit.
+func (l *Limiter) Allow(ctx context.Context, route string, client Client) (Result, error) {
+	if slices.Contains(l.config.Exempt, route) {
+		return Result{Allowed: true}, nil
+	}
+
+	limit, ok := l.routes[route]
+	if !ok {
+		limit = Limit{Rate: l.config.Rate, Burst: l.config.Burst}
+		route = "*"
+	}
+
+	result, err := l.store.Take(ctx, "ip:"+client.IP+"|"+route, limit)
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit: %w", err)
+	}
+
+	apiKey, ok := l.apiKey(client)
+	if !ok || !result.Allowed {
+		return result, nil
+	}
+
+	keyResult, err := l.store.Take(ctx, "apikey:"+apiKey+"|"+route, limit)
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit: %w", err)
+	}
+
+	if !keyResult.Allowed || keyResult.Remaining < result.Remaining {
+		return keyResult, nil
+	}
+
+	return result, nil
+}
+
+// HasRoute tells whether route has a limit of its own.
+func (l *Limiter) HasRoute(route string) bool {
+	_, ok := l.routes[route]
+
+	return ok
+}
+
+// ClientID identifies client by its API key when it is a known key, by its IP
+// otherwise. A nil limiter identifies clients by IP.
+func (l *Limiter) ClientID(client Client) string {
+	if l != nil {
+		if apiKey, ok := l.apiKey(client); ok {
+			return "apikey:" + apiKey
+		}
+	}
+
+	return "ip:" + client.IP
+}
+
+// APIKeyHeader is the header carrying API keys.
+func (l *Limiter) APIKeyHeader() string {
+	return l.config.APIKeyHeader
+}
+
+func (l *Limiter) Close() error {
+	return l.store.Close() //nolint:wrapcheck
+}
+
+// apiKey returns the hash of the API key of client when it is a known key,
+// keys are hashed to keep them out of the store.
+func (l *Limiter) apiKey(client Client) (string, bool) {
+	if l.config.Key != KeyAPIKey || client.APIKey == "" {
+		return "", false
+	}
+
+	hash := hashAPIKey(client.APIKey)
+
+	return hash, l.apiKeys[hash]
+}
+
+func hashAPIKey(apiKey string) string {
+	hash := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(hash[:16])
+}
+
+// HostIP returns the host of a host:port address.
+func HostIP(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+
+	return host
+}
+
+// SetHeaders sets the RateLimit headers, see
+// https://datatracker.ietf.org/doc/draft-ietf-httpapi-ratelimit-headers,
+// and Retry-After when the request is limited. Nothing is set for exempt routes.
+func SetHeaders(header http.Header, result Result) {
+	if result.Limit == 0 {
+		return
+	}
+
+	header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("RateLimit-Reset", strconv.Itoa(seconds(result.Reset)))
+
+	if !result.Allowed {
+		header.Set("Retry-After", strconv.Itoa(seconds(result.RetryAfter)))
+	}
+}
+
+// seconds rounds up to whole seconds.
+func seconds(duration time.Duration) int {
+	return int(math.Ceil(duration.Seconds()))
+}
@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeScript is the token bucket of take, run atomically on the Redis clock.
+// It returns allowed, remaining tokens, retry after and reset in milliseconds.
+//
+//nolint:gochecknoglobals
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(state[1]) or burst
+local updated = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - updated) * rate)
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry = (1 - tokens) / rate
+end
+local reset = (burst - tokens) / rate
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(reset * 1000) + 1000)
+return {allowed, math.floor(tokens), math.ceil(retry * 1000), math.ceil(reset * 1000)}
+`)
+
+// Redis is a Store shared by instances, buckets expire once full.
+type Redis struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedis(config RedisConfig) *Redis {
+	return &Redis{
+		client: redis.NewClient(&redis.Options{
+			Addr:         config.Address,
+			Password:     config.Password,
+			DB:           config.DB,
+			DialTimeout:  config.Timeout,
+			ReadTimeout:  config.Timeout,
+			WriteTimeout: config.Timeout,
+		}),
+		prefix: config.Prefix,
+	}
+}
+
+func (r *Redis) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	values, err := takeScript.Run(ctx, r.client, []string{r.prefix + key},
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64), limit.Burst).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("redis take: %w", err)
+	}
+
+	const results = 4
+	if len(values) != results {
+		return Result{}, fmt.Errorf("redis take: %d results: %w", len(values), ErrRedisReply)
+	}
+
+	return Result{
+		Allowed:    values[0] == 1,
+		Limit:      limit.Burst,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+		Reset:      time.Duration(values[3]) * time.Millisecond,
+	}, nil
+}
+
+func (r *Redis) Close() error {
+	if err := r.client.Close(); err != nil {
+		return fmt.Errorf("redis close: %w", err)
+	}
+
+	return nil
+}
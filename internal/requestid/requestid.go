@@ -0,0 +1,81 @@
+package requestid
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Header carries the request id over HTTP and in Kafka messages.
+	Header = "X-Request-ID"
+	// MetadataKey carries the request id in gRPC metadata.
+	MetadataKey = "x-request-id"
+	// LogKey is the slog attribute key of the request id.
+	LogKey = "requestId"
+	// MaxLength is the longest request id accepted from clients.
+	MaxLength = 128
+)
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the request id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request id of ctx, empty when there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+
+	return id
+}
+
+// Resolve returns the request id sent by a client when it is valid, a new one otherwise.
+func Resolve(id string) string {
+	if !Valid(id) {
+		return uuid.NewString()
+	}
+
+	return id
+}
+
+// Valid reports whether id is a non empty string of at most MaxLength
+// printable ASCII characters, safe to echo in headers and logs.
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+
+	for i := range len(id) {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Attr returns the slog attribute of the request id of ctx,
+// an empty attribute, ignored by slog handlers, when there is none.
+func Attr(ctx context.Context) slog.Attr {
+	id := FromContext(ctx)
+	if id == "" {
+		return slog.Attr{}
+	}
+
+	return slog.String(LogKey, id)
+}
+
+// Handler puts the request id sent by the client, or a new one,
+// in the request context and in the response headers.
+func Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		id := Resolve(request.Header.Get(Header))
+		writer.Header().Set(Header, id)
+
+		next.ServeHTTP(writer, request.WithContext(NewContext(request.Context(), id)))
+	})
+}
@@ -0,0 +1,60 @@
+package requestid
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "request-42", Resolve("request-42"))
+
+	for _, invalid := range []string{"", "has space", "new\nline", "ünicode", strings.Repeat("a", MaxLength+1)} {
+		id := Resolve(invalid)
+		assert.NotEqual(t, invalid, id)
+		assert.True(t, Valid(id))
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	assert.Empty(t, FromContext(ctx))
+	assert.Equal(t, slog.Attr{}, Attr(ctx))
+
+	ctx = NewContext(ctx, "request-42")
+	assert.Equal(t, "request-42", FromContext(ctx))
+	assert.Equal(t, slog.String(LogKey, "request-42"), Attr(ctx))
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	var id string
+
+	handler := Handler(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+		id = FromContext(request.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Set(Header, "request-42")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	assert.Equal(t, "request-42", id)
+	assert.Equal(t, "request-42", recorder.Header().Get(Header))
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.NotEmpty(t, id)
+	assert.NotEqual(t, "request-42", id)
+	assert.Equal(t, id, recorder.Header().Get(Header))
+}
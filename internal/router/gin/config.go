@@ -1,13 +1,81 @@
 package gin
 
+import "time"
+
 type Config struct {
-	Mode string `yaml:"mode" mapstructure:"Mode"`
+	Mode            string                `yaml:"mode" mapstructure:"Mode"`
+	CORS            CORSConfig            `yaml:"cors" mapstructure:"CORS"`
+	SecurityHeaders SecurityHeadersConfig `yaml:"securityHeaders" mapstructure:"SecurityHeaders"`
+	// MaxBodySize limits request bodies in bytes, larger requests fail with 413, zero disables the limit.
+	MaxBodySize int64 `yaml:"maxBodySize" mapstructure:"MaxBodySize"`
+	// Timeout bounds the handling of requests, zero disables it. Routes override it per
+	// "METHOD /path", e.g. "GET /api/v1/users". Timed out requests fail with 503.
+	Timeout time.Duration        `yaml:"timeout" mapstructure:"Timeout"`
+	Routes  []RouteTimeoutConfig `yaml:"routes" mapstructure:"Routes"`
+	// TrustedProxies are the IPs and CIDR ranges of reverse proxies whose X-Forwarded-For
+	// header identifies clients, none by default: clients are identified by the remote address.
+	TrustedProxies []string `yaml:"trustedProxies" mapstructure:"TrustedProxies"`
+}
+
+// CORSConfig configures cross-origin requests, CORS is disabled when no origins are allowed.
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowedOrigins" mapstructure:"AllowedOrigins"`
+	AllowedMethods   []string      `yaml:"allowedMethods" mapstructure:"AllowedMethods"`
+	AllowedHeaders   []string      `yaml:"allowedHeaders" mapstructure:"AllowedHeaders"`
+	ExposedHeaders   []string      `yaml:"exposedHeaders" mapstructure:"ExposedHeaders"`
+	AllowCredentials bool          `yaml:"allowCredentials" mapstructure:"AllowCredentials"`
+	MaxAge           time.Duration `yaml:"maxAge" mapstructure:"MaxAge"`
+}
+
+type SecurityHeadersConfig struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// HSTSMaxAge is the Strict-Transport-Security max-age, zero omits the header.
+	// Browsers only honour it over HTTPS.
+	HSTSMaxAge            time.Duration `yaml:"hstsMaxAge" mapstructure:"HSTSMaxAge"`
+	HSTSIncludeSubdomains bool          `yaml:"hstsIncludeSubdomains" mapstructure:"HSTSIncludeSubdomains"`
+	// FrameOptions is the X-Frame-Options header, DENY or SAMEORIGIN.
+	FrameOptions   string `yaml:"frameOptions" mapstructure:"FrameOptions"`
+	ReferrerPolicy string `yaml:"referrerPolicy" mapstructure:"ReferrerPolicy"`
+}
+
+type RouteTimeoutConfig struct {
+	Route   string        `yaml:"route" mapstructure:"Route"`
+	Timeout time.Duration `yaml:"timeout" mapstructure:"Timeout"`
 }
 
 func Defaults() map[string]any {
 	return map[string]any{
-		"Router.Mode": defaultRouterMode,
+		"Router.Mode":                           defaultRouterMode,
+		"Router.CORS.AllowedMethods":            DefaultCORSAllowedMethods,
+		"Router.CORS.AllowedHeaders":            DefaultCORSAllowedHeaders,
+		"Router.CORS.ExposedHeaders":            DefaultCORSExposedHeaders,
+		"Router.CORS.MaxAge":                    DefaultCORSMaxAge,
+		"Router.SecurityHeaders.Enable":         true,
+		"Router.SecurityHeaders.HSTSMaxAge":     DefaultHSTSMaxAge,
+		"Router.SecurityHeaders.FrameOptions":   DefaultFrameOptions,
+		"Router.SecurityHeaders.ReferrerPolicy": DefaultReferrerPolicy,
+		"Router.MaxBodySize":                    DefaultMaxBodySize,
+		"Router.Timeout":                        DefaultTimeout,
+		"Router.TrustedProxies":                 []string{},
 	}
 }
 
-const defaultRouterMode = "release"
+const (
+	defaultRouterMode     = "release"
+	DefaultCORSMaxAge     = 2 * time.Hour
+	DefaultHSTSMaxAge     = 365 * 24 * time.Hour
+	DefaultFrameOptions   = "DENY"
+	DefaultReferrerPolicy = "no-referrer"
+	DefaultMaxBodySize    = 1 << 20
+	DefaultTimeout        = 10 * time.Second
+)
+
+//nolint:gochecknoglobals
+var (
+	DefaultCORSAllowedMethods = []string{"GET", "POST", "PUT", "DELETE"}
+	DefaultCORSAllowedHeaders = []string{"Content-Type", "Authorization", IdempotencyKeyHeader, RequestIDHeader}
+	DefaultCORSExposedHeaders = []string{
+		RequestIDHeader, IdempotentReplayedHeader, "Retry-After",
+		"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset",
+	}
+)
@@ -1,10 +1,12 @@
 package gin
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/health"
 )
 
 type Controller struct {
@@ -12,20 +14,39 @@ type Controller struct {
 	handler *gin.Engine
 }
 
-func New(config Config, domain domain.Contract, handlers ...gin.HandlerFunc) *Controller {
+// New creates the router, liveness and readiness are reported by
+// the health registry, always up without one.
+func New(config Config, domain domain.Contract, registry *health.Registry, handlers ...gin.HandlerFunc) (*Controller, error) {
 	controller := Controller{
 		domain: domain,
 	}
 
+	if registry == nil {
+		registry = health.New(health.Config{})
+	}
+
 	// Gin routing engine.
 	gin.SetMode(config.Mode)
 	engine := gin.New()
 	engine.RedirectTrailingSlash = false
-	engine.Use(gin.Recovery(), Logger())
+
+	// Gin trusts all proxies by default, forwarded client IPs are only honoured from trusted ones.
+	if err := engine.SetTrustedProxies(config.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("trusted proxies: %w", err)
+	}
+
+	engine.Use(RequestID(), Recovery(), Logger(), Problems())
+	engine.Use(middleware(config)...)
 	engine.Use(handlers...)
 
-	// Health check.
+	engine.NoRoute(func(gctx *gin.Context) {
+		_ = gctx.Error(ErrRouteNotFound)
+	})
+
+	// Health checks.
 	engine.GET("/health", controller.health)
+	engine.GET(health.LivenessPath, gin.WrapF(registry.ServeLivenessSummary))
+	engine.GET(health.ReadinessPath, gin.WrapF(registry.ServeReadinessSummary))
 
 	// API endpoints.
 	group := engine.Group("/api/v1")
@@ -35,11 +56,40 @@ func New(config Config, domain domain.Contract, handlers ...gin.HandlerFunc) *Co
 		group.GET("/users", controller.list)
 		group.PUT("/user/:id", controller.update)
 		group.DELETE("/user/:id", controller.delete)
+
+		// API documentation.
+		group.GET("/openapi.json", controller.openAPI)
+		group.GET("/docs", controller.swaggerUI)
+		group.GET("/docs/swagger-ui.css", controller.swaggerAsset("swagger-ui.css"))
+		group.GET("/docs/swagger-ui-bundle.js", controller.swaggerAsset("swagger-ui-bundle.js"))
 	}
 
 	controller.handler = engine
 
-	return &controller
+	return &controller, nil
+}
+
+// middleware are the configured request guards.
+func middleware(config Config) []gin.HandlerFunc {
+	var handlers []gin.HandlerFunc
+
+	if config.SecurityHeaders.Enable {
+		handlers = append(handlers, SecurityHeaders(config.SecurityHeaders))
+	}
+
+	if len(config.CORS.AllowedOrigins) > 0 {
+		handlers = append(handlers, CORS(config.CORS))
+	}
+
+	if config.MaxBodySize > 0 {
+		handlers = append(handlers, MaxBodySize(config.MaxBodySize))
+	}
+
+	if config.Timeout > 0 || len(config.Routes) > 0 {
+		handlers = append(handlers, Timeout(config.Timeout, config.Routes))
+	}
+
+	return handlers
 }
 
 func (c *Controller) Handler() http.Handler {
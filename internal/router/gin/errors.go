@@ -2,4 +2,9 @@ package gin
 
 import "errors"
 
-var ErrUUIDConflict = errors.New("uuid conflict")
+var (
+	ErrUUIDConflict     = errors.New("uuid conflict")
+	ErrMalformedRequest = errors.New("malformed request")
+	ErrRouteNotFound    = errors.New("route not found")
+	ErrPanic            = errors.New("panic")
+)
@@ -1,35 +1,67 @@
 package gin
 
 import (
+	_ "embed"
+	"fmt"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	swaggerfiles "github.com/swaggo/files/v2"
 	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/contract/openapi"
 )
 
+// swaggerHTML is the Swagger UI page, its assets are embedded in the binary.
+//
+//nolint:gochecknoglobals
+//go:embed swagger.html
+var swaggerHTML []byte
+
+// swaggerAssets are the embedded Swagger UI files.
+//
+//nolint:gochecknoglobals
+var swaggerAssets = http.FS(swaggerfiles.FS)
+
 func (c *Controller) health(gctx *gin.Context) {
 	gctx.JSON(http.StatusOK, gin.H{"message": "healthy"})
 }
 
+// openAPI serves the OpenAPI document of the API.
+func (c *Controller) openAPI(gctx *gin.Context) {
+	gctx.Data(http.StatusOK, "application/json; charset=utf-8", openapi.Spec)
+}
+
+// swaggerUI serves Swagger UI for the OpenAPI document.
+func (c *Controller) swaggerUI(gctx *gin.Context) {
+	gctx.Data(http.StatusOK, "text/html; charset=utf-8", swaggerHTML)
+}
+
+// swaggerAsset serves an embedded Swagger UI file.
+func (c *Controller) swaggerAsset(name string) gin.HandlerFunc {
+	return func(gctx *gin.Context) {
+		gctx.FileFromFS(name, swaggerAssets)
+	}
+}
+
 func (c *Controller) create(gctx *gin.Context) {
 	var userInput dto.UserInput
 	if err := gctx.ShouldBindJSON(&userInput); err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(fmt.Errorf("%w: %w", ErrMalformedRequest, err))
 
 		return
 	}
 
 	if err := userInput.Validate(); err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
 
 	createdUser, err := c.domain.Create(gctx.Request.Context(), dto.UserInputToDomain(userInput))
 	if err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
@@ -38,16 +70,16 @@ func (c *Controller) create(gctx *gin.Context) {
 }
 
 func (c *Controller) update(gctx *gin.Context) {
-	userID, err := uuid.Parse(gctx.Param("id"))
+	userID, err := userIDParam(gctx)
 	if err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
 
 	var user dto.UserUpdate
 	if err := gctx.ShouldBindJSON(&user); err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(fmt.Errorf("%w: %w", ErrMalformedRequest, err))
 
 		return
 	}
@@ -55,14 +87,14 @@ func (c *Controller) update(gctx *gin.Context) {
 	user.ID = userID
 
 	if err := user.Validate(); err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
 
 	updatedUser, err := c.domain.Update(gctx.Request.Context(), dto.UserUpdateToDomain(user))
 	if err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
@@ -71,16 +103,16 @@ func (c *Controller) update(gctx *gin.Context) {
 }
 
 func (c *Controller) get(gctx *gin.Context) {
-	userID, err := uuid.Parse(gctx.Param("id"))
+	userID, err := userIDParam(gctx)
 	if err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
 
 	user, err := c.domain.Get(gctx.Request.Context(), userID)
 	if err != nil {
-		gctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
@@ -96,28 +128,28 @@ func (c *Controller) list(gctx *gin.Context) {
 
 	page, err := strconv.Atoi(gctx.DefaultQuery("page", strconv.Itoa(defaultPage)))
 	if err != nil || page <= 0 {
-		gctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = gctx.Error(dto.NewFieldError("page", "must be a positive integer", dto.ErrPage))
 
 		return
 	}
 
 	limit, err := strconv.Atoi(gctx.DefaultQuery("limit", strconv.Itoa(defaultLimit)))
-	if err != nil || page <= 0 {
-		gctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if err != nil || limit <= 0 {
+		_ = gctx.Error(dto.NewFieldError("limit", "must be a positive integer", dto.ErrLimit))
 
 		return
 	}
 
 	country := gctx.Query("country")
-	if err := dto.ValidateCountryCode(country); err != nil && country != "" {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if err := dto.ValidateCountryCode(country); err != nil {
+		_ = gctx.Error(dto.NewFieldError("country", dto.DetailCountryCode, err))
 
 		return
 	}
 
 	list, err := c.domain.List(gctx.Request.Context(), page, limit, country)
 	if err != nil {
-		gctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
@@ -126,19 +158,28 @@ func (c *Controller) list(gctx *gin.Context) {
 }
 
 func (c *Controller) delete(gctx *gin.Context) {
-	userID, err := uuid.Parse(gctx.Param("id"))
+	userID, err := userIDParam(gctx)
 	if err != nil {
-		gctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
 
 	err = c.domain.Delete(gctx.Request.Context(), userID)
 	if err != nil {
-		gctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		_ = gctx.Error(err)
 
 		return
 	}
 
 	gctx.Status(http.StatusNoContent)
 }
+
+func userIDParam(gctx *gin.Context) (uuid.UUID, error) {
+	userID, err := uuid.Parse(gctx.Param("id"))
+	if err != nil {
+		return uuid.Nil, dto.NewFieldError("id", "must be a UUID", dto.ErrID)
+	}
+
+	return userID, nil
+}
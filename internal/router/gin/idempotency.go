@@ -0,0 +1,120 @@
+package gin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	idempotencyKeyDetail     = "must be at most 255 characters"
+)
+
+// Idempotency middleware replays the stored response of POST and PUT requests
+// retried with the same Idempotency-Key header. Only successful responses are
+// stored, the key of a failed request is released so that it can be retried.
+// Keys are scoped to the client as identified by the rate limiter, by IP
+// without one, so that clients cannot replay the responses of each other.
+func Idempotency(store idempotency.Store, limiter *ratelimit.Limiter, proxies ratelimit.Proxies) gin.HandlerFunc {
+	return func(gctx *gin.Context) {
+		header := gctx.GetHeader(IdempotencyKeyHeader)
+		if header == "" || (gctx.Request.Method != http.MethodPost && gctx.Request.Method != http.MethodPut) {
+			gctx.Next()
+
+			return
+		}
+
+		if err := idempotency.ValidateKey(header); err != nil {
+			abortWithError(gctx, dto.NewFieldError(IdempotencyKeyHeader, idempotencyKeyDetail, err))
+
+			return
+		}
+
+		body, err := io.ReadAll(gctx.Request.Body)
+		if err != nil {
+			abortWithError(gctx, fmt.Errorf("%w: %w", ErrMalformedRequest, err))
+
+			return
+		}
+
+		gctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := gctx.Request.Context()
+		key := idempotency.ClientKey(limiter.ClientID(rateLimitClient(gctx, limiter, proxies)), header)
+		fingerprint := idempotency.Fingerprint([]byte(gctx.Request.Method), []byte(gctx.Request.URL.Path), body)
+
+		stored, err := store.Begin(ctx, key, fingerprint)
+		if err != nil {
+			abortWithError(gctx, err)
+
+			return
+		}
+
+		if stored != nil {
+			gctx.Header(IdempotentReplayedHeader, "true")
+			gctx.Data(stored.Status, stored.ContentType, stored.Body)
+			gctx.Abort()
+
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: gctx.Writer}
+		gctx.Writer = recorder
+
+		gctx.Next()
+
+		// Store the response even when the client went away meanwhile.
+		ctx = context.WithoutCancel(ctx)
+
+		if status := recorder.Status(); len(gctx.Errors) == 0 && status >= 200 && status < 300 {
+			err = store.Complete(ctx, key, idempotency.Response{
+				Status:      status,
+				ContentType: recorder.Header().Get("Content-Type"),
+				Body:        recorder.body.Bytes(),
+			})
+		} else {
+			err = store.Release(ctx, key)
+		}
+
+		if err != nil {
+			slogw.DefaultLogger().ErrorContext(ctx, "idempotency",
+				slog.String("key", header),
+				slog.String("store", err.Error()),
+			)
+		}
+	}
+}
+
+func abortWithError(gctx *gin.Context, err error) {
+	_ = gctx.Error(err)
+	gctx.Abort()
+}
+
+// responseRecorder captures the response body.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+
+	return r.ResponseWriter.Write(data) //nolint:wrapcheck
+}
+
+func (r *responseRecorder) WriteString(data string) (int, error) {
+	r.body.WriteString(data)
+
+	return r.ResponseWriter.WriteString(data) //nolint:wrapcheck
+}
@@ -0,0 +1,89 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+)
+
+func TestIdempotency(t *testing.T) {
+	t.Parallel()
+
+	const body = `{"firstName":"John","lastName":"Doe","nickname":"jd","email":"john@example.com"}`
+
+	createdUser := domain.User{ID: uuid.New(), FirstName: "John", LastName: "Doe", Email: "john@example.com"}
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().Create(mock.Anything, mock.Anything).Return(&createdUser, nil).Twice()
+	mockDomain.EXPECT().Create(mock.Anything, mock.Anything).
+		Return(nil, errors.New("storage unavailable")).Once() //nolint:err113
+	mockDomain.EXPECT().Create(mock.Anything, mock.Anything).Return(&createdUser, nil).Once()
+
+	store := idempotency.NewMemory(idempotency.Config{TTL: time.Hour, LockTimeout: time.Minute})
+	controller, err := New(Config{Mode: gin.TestMode}, &mockDomain, nil, Idempotency(store, nil, nil))
+	require.NoError(t, err)
+
+	createFrom := func(remoteAddr, key, body string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/user", strings.NewReader(body))
+		request.RemoteAddr = remoteAddr
+		request.Header.Set("Content-Type", "application/json")
+		request.Header.Set(IdempotencyKeyHeader, key)
+
+		recorder := httptest.NewRecorder()
+		controller.Handler().ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	create := func(key, body string) *httptest.ResponseRecorder {
+		return createFrom("192.0.2.1:1234", key, body)
+	}
+
+	first := create("key-1", body)
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+	// Retry replays the response without creating another user.
+	retry := create("key-1", body)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, first.Header().Get("Content-Type"), retry.Header().Get("Content-Type"))
+	assert.JSONEq(t, first.Body.String(), retry.Body.String())
+
+	// Keys are scoped to clients, another client does not get the response.
+	other := createFrom("192.0.2.2:1234", "key-1", body)
+	assert.Equal(t, http.StatusCreated, other.Code)
+	assert.Empty(t, other.Header().Get(IdempotentReplayedHeader))
+
+	// Reuse with a different payload.
+	reused := create("key-1", strings.Replace(body, "John", "Jane", 1))
+	assert.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+	assert.Contains(t, reused.Body.String(), dto.CodeIdempotencyKeyReused)
+
+	// Failed requests are not stored and can be retried.
+	failed := create("key-2", body)
+	assert.Equal(t, http.StatusInternalServerError, failed.Code)
+
+	retried := create("key-2", body)
+	assert.Equal(t, http.StatusCreated, retried.Code)
+	assert.Empty(t, retried.Header().Get(IdempotentReplayedHeader))
+
+	// Keys are limited in length.
+	long := create(strings.Repeat("k", idempotency.MaxKeyLength+1), body)
+	assert.Equal(t, http.StatusBadRequest, long.Code)
+	assert.Contains(t, long.Body.String(), IdempotencyKeyHeader)
+
+	mockDomain.AssertExpectations(t)
+}
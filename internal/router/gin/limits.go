@@ -0,0 +1,54 @@
+package gin
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxBodySize middleware limits request bodies to size bytes. Requests declaring a larger
+// body are rejected upfront, reading past the limit fails with *http.MaxBytesError.
+func MaxBodySize(size int64) gin.HandlerFunc {
+	return func(gctx *gin.Context) {
+		if gctx.Request.ContentLength > size {
+			abortWithError(gctx, &http.MaxBytesError{Limit: size})
+
+			return
+		}
+
+		gctx.Request.Body = http.MaxBytesReader(gctx.Writer, gctx.Request.Body, size)
+
+		gctx.Next()
+	}
+}
+
+// Timeout middleware sets the deadline of requests, routes override the timeout
+// per "METHOD /path". Handlers stop at the deadline of their context.
+func Timeout(timeout time.Duration, routes []RouteTimeoutConfig) gin.HandlerFunc {
+	timeouts := make(map[string]time.Duration, len(routes))
+	for _, route := range routes {
+		timeouts[route.Route] = route.Timeout
+	}
+
+	return func(gctx *gin.Context) {
+		routeTimeout, ok := timeouts[gctx.Request.Method+" "+gctx.FullPath()]
+		if !ok {
+			routeTimeout = timeout
+		}
+
+		if routeTimeout <= 0 {
+			gctx.Next()
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(gctx.Request.Context(), routeTimeout)
+		defer cancel()
+
+		gctx.Request = gctx.Request.WithContext(ctx)
+
+		gctx.Next()
+	}
+}
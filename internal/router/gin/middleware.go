@@ -1,13 +1,28 @@
 package gin
 
 import (
+	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
 )
 
+// RequestID middleware puts the request id sent by the client, or a new one,
+// in the request context and in the response headers.
+func RequestID() gin.HandlerFunc {
+	return func(gctx *gin.Context) {
+		id := requestid.Resolve(gctx.GetHeader(RequestIDHeader))
+
+		gctx.Header(RequestIDHeader, id)
+		gctx.Request = gctx.Request.WithContext(requestid.NewContext(gctx.Request.Context(), id))
+
+		gctx.Next()
+	}
+}
+
 // Logger middleware logs HTTP requests.
 func Logger() gin.HandlerFunc {
 	return func(gctx *gin.Context) {
@@ -20,6 +35,7 @@ func Logger() gin.HandlerFunc {
 			"http request begin",
 			slog.String("method", gctx.Request.Method),
 			slog.String("path", gctx.Request.URL.Path),
+			requestid.Attr(gctx.Request.Context()),
 		)
 
 		gctx.Next()
@@ -31,6 +47,26 @@ func Logger() gin.HandlerFunc {
 			slog.String("path", gctx.Request.URL.Path),
 			slog.Int("status", gctx.Writer.Status()),
 			slog.Duration("duration(ms)", time.Since(start).Round(time.Millisecond)),
+			requestid.Attr(gctx.Request.Context()),
 		)
 	}
 }
+
+// Problems middleware writes the last error of a request as RFC 9457
+// problem details, handlers record errors with gin.Context.Error.
+func Problems() gin.HandlerFunc {
+	return func(gctx *gin.Context) {
+		gctx.Next()
+
+		if err := gctx.Errors.Last(); err != nil && !gctx.Writer.Written() {
+			writeProblem(gctx, err.Err)
+		}
+	}
+}
+
+// Recovery middleware writes recovered panics as problem details.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(gctx *gin.Context, recovered any) {
+		writeProblem(gctx, fmt.Errorf("%w: %v", ErrPanic, recovered))
+	})
+}
@@ -0,0 +1,108 @@
+package gin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/openapi"
+)
+
+// documentationRoutes serve the specification itself and are not part of it.
+//
+//nolint:gochecknoglobals
+var documentationRoutes = []string{
+	"GET /api/v1/openapi.json",
+	"GET /api/v1/docs",
+	"GET /api/v1/docs/swagger-ui.css",
+	"GET /api/v1/docs/swagger-ui-bundle.js",
+}
+
+func TestOpenAPI_MatchesRoutes(t *testing.T) {
+	t.Parallel()
+
+	var spec struct {
+		OpenAPI string                                `json:"openapi"`
+		Paths   map[string]map[string]json.RawMessage `json:"paths"`
+	}
+
+	require.NoError(t, json.Unmarshal(openapi.Spec, &spec))
+	assert.Equal(t, "3.1.0", spec.OpenAPI)
+
+	var documented []string
+
+	for path, item := range spec.Paths {
+		for method := range item {
+			if method == "parameters" {
+				continue
+			}
+
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	controller, err := New(Config{Mode: gin.TestMode}, nil, nil)
+	require.NoError(t, err)
+
+	engine, ok := controller.Handler().(*gin.Engine)
+	require.True(t, ok)
+
+	pathParam := regexp.MustCompile(`:(\w+)`)
+
+	var routes []string
+
+	for _, route := range engine.Routes() {
+		route := route.Method + " " + pathParam.ReplaceAllString(route.Path, "{$1}")
+		if !slices.Contains(documentationRoutes, route) {
+			routes = append(routes, route)
+		}
+	}
+
+	assert.ElementsMatch(t, routes, documented)
+}
+
+func TestOpenAPI_References(t *testing.T) {
+	t.Parallel()
+
+	var spec map[string]any
+	require.NoError(t, json.Unmarshal(openapi.Spec, &spec))
+
+	for _, ref := range regexp.MustCompile(`"\$ref":\s*"#/([^"]+)"`).FindAllStringSubmatch(string(openapi.Spec), -1) {
+		var node any = spec
+
+		for _, key := range strings.Split(ref[1], "/") {
+			object, ok := node.(map[string]any)
+			require.True(t, ok, ref[1])
+
+			node, ok = object[key]
+			require.True(t, ok, "unresolved reference %s", ref[1])
+		}
+	}
+}
+
+func TestOpenAPI_Serve(t *testing.T) {
+	t.Parallel()
+
+	controller, err := New(Config{Mode: gin.TestMode}, nil, nil)
+	require.NoError(t, err)
+
+	for target, contentType := range map[string]string{
+		"/api/v1/openapi.json":              "application/json; charset=utf-8",
+		"/api/v1/docs":                      "text/html; charset=utf-8",
+		"/api/v1/docs/swagger-ui.css":       "text/css; charset=utf-8",
+		"/api/v1/docs/swagger-ui-bundle.js": "text/javascript; charset=utf-8",
+	} {
+		recorder := httptest.NewRecorder()
+		controller.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code, target)
+		assert.Equal(t, contentType, recorder.Header().Get("Content-Type"), target)
+	}
+}
@@ -0,0 +1,122 @@
+package gin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
+)
+
+// RequestIDHeader carries the request id, generated when the client sends none.
+const RequestIDHeader = requestid.Header
+
+// problemTitles are the short, occurrence independent summaries of the problem codes.
+//
+//nolint:gochecknoglobals
+var problemTitles = map[string]string{
+	dto.CodeValidationFailed: "Validation failed",
+	dto.CodeMalformedRequest: "Malformed request",
+	dto.CodeUserNotFound:     "User not found",
+	dto.CodeUserConflict:     "User conflict",
+	dto.CodeRouteNotFound:    "Route not found",
+	dto.CodeInternalError:    "Internal error",
+	dto.CodeRateLimited:      "Too many requests",
+	dto.CodeRequestTooLarge:  "Request too large",
+	dto.CodeRequestTimeout:   "Request timeout",
+
+	dto.CodeIdempotencyKeyReused:     "Idempotency key reused",
+	dto.CodeIdempotencyKeyInProgress: "Idempotency key in progress",
+}
+
+// problemFor maps an error to problem details. The detail never contains
+// the error text, which may carry storage internals, except for validation.
+func problemFor(err error) dto.Problem {
+	var (
+		validation *dto.ValidationError
+		tooLarge   *http.MaxBytesError
+	)
+
+	switch {
+	case errors.As(err, &validation):
+		problem := newProblem(http.StatusBadRequest, dto.CodeValidationFailed, "The request has invalid fields.")
+		problem.Errors = validation.Fields
+
+		return problem
+	case errors.As(err, &tooLarge):
+		return newProblem(http.StatusRequestEntityTooLarge, dto.CodeRequestTooLarge,
+			"The request body exceeds the size limit.")
+	case errors.Is(err, ErrMalformedRequest):
+		return newProblem(http.StatusBadRequest, dto.CodeMalformedRequest, "The request body is not valid JSON.")
+	case errors.Is(err, domain.ErrNotFound):
+		return newProblem(http.StatusNotFound, dto.CodeUserNotFound, "The user does not exist.")
+	case errors.Is(err, domain.ErrConflict):
+		return newProblem(http.StatusConflict, dto.CodeUserConflict, "A user with this email already exists.")
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return newProblem(http.StatusUnprocessableEntity, dto.CodeIdempotencyKeyReused,
+			"The idempotency key was used with a different request.")
+	case errors.Is(err, idempotency.ErrInProgress):
+		return newProblem(http.StatusConflict, dto.CodeIdempotencyKeyInProgress,
+			"A request with this idempotency key is in progress.")
+	case errors.Is(err, ratelimit.ErrLimited):
+		return newProblem(http.StatusTooManyRequests, dto.CodeRateLimited,
+			"The request rate limit is exceeded, retry after Retry-After seconds.")
+	case errors.Is(err, context.DeadlineExceeded):
+		return newProblem(http.StatusServiceUnavailable, dto.CodeRequestTimeout,
+			"The request did not complete in time.")
+	case errors.Is(err, ErrRouteNotFound):
+		return newProblem(http.StatusNotFound, dto.CodeRouteNotFound, "The requested route does not exist.")
+	}
+
+	return newProblem(http.StatusInternalServerError, dto.CodeInternalError, "The request could not be processed.")
+}
+
+func newProblem(status int, code, detail string) dto.Problem {
+	return dto.Problem{
+		Type:   dto.ProblemTypeBase + strings.ToLower(code),
+		Title:  problemTitles[code],
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// writeProblem aborts the request with the problem details of err.
+func writeProblem(gctx *gin.Context, err error) {
+	problem := problemFor(err)
+	problem.Instance = gctx.Request.URL.Path
+	problem.RequestID = requestID(gctx)
+
+	if problem.Status >= http.StatusInternalServerError {
+		slogw.DefaultLogger().ErrorContext(gctx.Request.Context(), "http request",
+			slog.String("method", gctx.Request.Method),
+			slog.String("path", gctx.Request.URL.Path),
+			slog.String("error", err.Error()),
+			requestid.Attr(gctx.Request.Context()),
+		)
+	}
+
+	gctx.Header("Content-Type", dto.ProblemContentType)
+	gctx.AbortWithStatusJSON(problem.Status, problem)
+}
+
+// requestID returns the request id set by the RequestID middleware,
+// or a new one when it did not run, and echoes it in the response.
+func requestID(gctx *gin.Context) string {
+	id := requestid.FromContext(gctx.Request.Context())
+	if id == "" {
+		id = requestid.Resolve(gctx.GetHeader(RequestIDHeader))
+	}
+
+	gctx.Header(RequestIDHeader, id)
+
+	return id
+}
@@ -0,0 +1,216 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
+)
+
+func TestProblems(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	storageErr := errors.New("ERROR: duplicate key value violates unique constraint") //nolint:err113
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		mock   func(*domain.MockContract)
+		status int
+		code   string
+		fields []string
+	}{
+		{
+			name:   "invalid id",
+			method: http.MethodGet,
+			target: "/api/v1/user/42",
+			status: http.StatusBadRequest,
+			code:   dto.CodeValidationFailed,
+			fields: []string{"id"},
+		},
+		{
+			name:   "invalid fields",
+			method: http.MethodPost,
+			target: "/api/v1/user",
+			body:   `{"firstName":"John","email":"john","country":"gb"}`,
+			status: http.StatusBadRequest,
+			code:   dto.CodeValidationFailed,
+			fields: []string{"lastName", "nickname", "email", "country"},
+		},
+		{
+			name:   "unassigned country",
+			method: http.MethodGet,
+			target: "/api/v1/users?page=1&limit=10&country=ZZ",
+			status: http.StatusBadRequest,
+			code:   dto.CodeValidationFailed,
+			fields: []string{"country"},
+		},
+		{
+			name:   "invalid page",
+			method: http.MethodGet,
+			target: "/api/v1/users?page=0",
+			status: http.StatusBadRequest,
+			code:   dto.CodeValidationFailed,
+			fields: []string{"page"},
+		},
+		{
+			name:   "malformed body",
+			method: http.MethodPut,
+			target: "/api/v1/user/" + userID.String(),
+			body:   `{"firstName":`,
+			status: http.StatusBadRequest,
+			code:   dto.CodeMalformedRequest,
+		},
+		{
+			name:   "not found",
+			method: http.MethodGet,
+			target: "/api/v1/user/" + userID.String(),
+			mock: func(m *domain.MockContract) {
+				m.EXPECT().Get(mock.Anything, userID).
+					Return(nil, fmt.Errorf("get user: %w", fmt.Errorf("%w: %w", domain.ErrNotFound, storageErr)))
+			},
+			status: http.StatusNotFound,
+			code:   dto.CodeUserNotFound,
+		},
+		{
+			name:   "conflict",
+			method: http.MethodPost,
+			target: "/api/v1/user",
+			body:   `{"firstName":"John","lastName":"Doe","nickname":"jd","email":"john@example.com"}`,
+			mock: func(m *domain.MockContract) {
+				m.EXPECT().Create(mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("create user: %w", fmt.Errorf("%w: %w", domain.ErrConflict, storageErr)))
+			},
+			status: http.StatusConflict,
+			code:   dto.CodeUserConflict,
+		},
+		{
+			name:   "internal error",
+			method: http.MethodDelete,
+			target: "/api/v1/user/" + userID.String(),
+			mock: func(m *domain.MockContract) {
+				m.EXPECT().Delete(mock.Anything, userID).Return(fmt.Errorf("delete user: %w", storageErr))
+			},
+			status: http.StatusInternalServerError,
+			code:   dto.CodeInternalError,
+		},
+		{
+			name:   "unknown route",
+			method: http.MethodGet,
+			target: "/api/v2/users",
+			status: http.StatusNotFound,
+			code:   dto.CodeRouteNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDomain := domain.MockContract{}
+			if test.mock != nil {
+				test.mock(&mockDomain)
+			}
+
+			controller, err := New(Config{Mode: gin.TestMode}, &mockDomain, nil)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set(RequestIDHeader, "request-42")
+
+			recorder := httptest.NewRecorder()
+			controller.Handler().ServeHTTP(recorder, request)
+
+			assert.Equal(t, test.status, recorder.Code)
+			assert.Equal(t, dto.ProblemContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, "request-42", recorder.Header().Get(RequestIDHeader))
+			assert.NotContains(t, recorder.Body.String(), "duplicate key")
+
+			var problem dto.Problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+
+			assert.Equal(t, test.status, problem.Status)
+			assert.Equal(t, test.code, problem.Code)
+			assert.Equal(t, problemTitles[test.code], problem.Title)
+			assert.True(t, strings.HasPrefix(problem.Type, dto.ProblemTypeBase))
+			assert.Equal(t, request.URL.Path, problem.Instance)
+			assert.Equal(t, "request-42", problem.RequestID)
+
+			fields := make([]string, len(problem.Errors))
+			for i, fieldError := range problem.Errors {
+				fields[i] = fieldError.Field
+			}
+
+			assert.ElementsMatch(t, test.fields, fields)
+		})
+	}
+}
+
+func TestProblems_Recovery(t *testing.T) {
+	t.Parallel()
+
+	engine := gin.New()
+	engine.Use(Recovery(), Problems())
+	engine.GET("/panic", func(*gin.Context) { panic("boom") })
+
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, dto.ProblemContentType, recorder.Header().Get("Content-Type"))
+	assert.NotEmpty(t, recorder.Header().Get(RequestIDHeader))
+	assert.NotContains(t, recorder.Body.String(), "boom")
+}
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	var requestID string
+
+	mockDomain := domain.MockContract{}
+	mockDomain.On("Get", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			ctx, _ := args.Get(0).(context.Context)
+			requestID = requestid.FromContext(ctx)
+		}).
+		Return(&domain.User{}, nil)
+
+	controller, err := New(Config{Mode: gin.TestMode}, &mockDomain, nil)
+	require.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/user/"+uuid.NewString(), nil)
+	request.Header.Set(RequestIDHeader, "request-42")
+
+	recorder := httptest.NewRecorder()
+	controller.Handler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "request-42", recorder.Header().Get(RequestIDHeader))
+	assert.Equal(t, "request-42", requestID)
+
+	// Invalid request ids are replaced.
+	request.Header.Set(RequestIDHeader, "request 42")
+
+	recorder = httptest.NewRecorder()
+	controller.Handler().ServeHTTP(recorder, request)
+
+	assert.Equal(t, requestID, recorder.Header().Get(RequestIDHeader))
+	assert.NotEqual(t, "request 42", requestID)
+}
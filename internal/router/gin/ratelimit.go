@@ -0,0 +1,50 @@
+package gin
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+// RateLimit middleware limits the requests of clients per route, limited requests
+// get a 429 problem with a Retry-After header. Requests are let through when the
+// store fails. Clients behind trusted proxies are identified by X-Forwarded-For.
+func RateLimit(limiter *ratelimit.Limiter, proxies ratelimit.Proxies) gin.HandlerFunc {
+	return func(gctx *gin.Context) {
+		ctx := gctx.Request.Context()
+
+		result, err := limiter.Allow(ctx, gctx.Request.Method+" "+gctx.FullPath(), rateLimitClient(gctx, limiter, proxies))
+		if err != nil {
+			slogw.DefaultLogger().ErrorContext(ctx, "rate limit",
+				slog.String("allow", err.Error()),
+			)
+
+			gctx.Next()
+
+			return
+		}
+
+		ratelimit.SetHeaders(gctx.Writer.Header(), result)
+
+		if !result.Allowed {
+			abortWithError(gctx, ratelimit.ErrLimited)
+
+			return
+		}
+
+		gctx.Next()
+	}
+}
+
+// rateLimitClient identifies the client of a request, clients behind trusted proxies
+// are identified by X-Forwarded-For. A nil limiter does not read API keys.
+func rateLimitClient(gctx *gin.Context, limiter *ratelimit.Limiter, proxies ratelimit.Proxies) ratelimit.Client {
+	client := ratelimit.Client{IP: proxies.ClientIP(gctx.Request)}
+	if limiter != nil {
+		client.APIKey = gctx.GetHeader(limiter.APIKeyHeader())
+	}
+
+	return client
+}
@@ -0,0 +1,74 @@
+package gin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(ratelimit.Config{
+		Key:    ratelimit.KeyIP,
+		Rate:   0.5,
+		Burst:  1,
+		Exempt: []string{"GET /api/v1/openapi.json"},
+	})
+	require.NoError(t, err)
+
+	controller, err := New(Config{Mode: gin.TestMode}, nil, nil, RateLimit(limiter, nil))
+	require.NoError(t, err)
+
+	forwarded := 0
+
+	get := func(target string) *httptest.ResponseRecorder {
+		forwarded++
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, target, nil)
+		// Forwarded addresses from untrusted remotes are ignored.
+		request.Header.Set("X-Forwarded-For", "198.51.100."+strconv.Itoa(forwarded))
+		controller.Handler().ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	allowed := get("/health")
+	assert.Equal(t, http.StatusOK, allowed.Code)
+	assert.Equal(t, "1", allowed.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", allowed.Header().Get("RateLimit-Remaining"))
+	assert.Empty(t, allowed.Header().Get("Retry-After"))
+
+	limited := get("/health")
+	require.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, dto.ProblemContentType, limited.Header().Get("Content-Type"))
+	assert.Equal(t, "2", limited.Header().Get("Retry-After"))
+	assert.Equal(t, "2", limited.Header().Get("RateLimit-Reset"))
+
+	var problem dto.Problem
+	require.NoError(t, json.Unmarshal(limited.Body.Bytes(), &problem))
+	assert.Equal(t, dto.CodeRateLimited, problem.Code)
+	assert.Equal(t, http.StatusTooManyRequests, problem.Status)
+
+	// Exempt routes are served without headers.
+	exempt := get("/api/v1/openapi.json")
+	assert.Equal(t, http.StatusOK, exempt.Code)
+	assert.Empty(t, exempt.Header().Get("RateLimit-Limit"))
+}
+
+func TestNew_InvalidTrustedProxies(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Config{Mode: gin.TestMode, TrustedProxies: []string{"10.0.0.0/33"}}, nil, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "trusted proxies")
+}
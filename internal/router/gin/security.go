@@ -0,0 +1,67 @@
+package gin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/cors"
+)
+
+// CORS middleware answers preflight requests and sets the CORS headers of
+// requests from the allowed origins.
+func CORS(config CORSConfig) gin.HandlerFunc {
+	handler := cors.New(cors.Options{
+		AllowedOrigins:   config.AllowedOrigins,
+		AllowedMethods:   config.AllowedMethods,
+		AllowedHeaders:   config.AllowedHeaders,
+		ExposedHeaders:   config.ExposedHeaders,
+		AllowCredentials: config.AllowCredentials,
+		MaxAge:           int(config.MaxAge.Seconds()),
+	})
+
+	return func(gctx *gin.Context) {
+		handler.HandlerFunc(gctx.Writer, gctx.Request)
+
+		// Preflight requests are answered, they do not reach the routes.
+		if gctx.Request.Method == http.MethodOptions && gctx.GetHeader("Access-Control-Request-Method") != "" {
+			gctx.AbortWithStatus(http.StatusNoContent)
+
+			return
+		}
+
+		gctx.Next()
+	}
+}
+
+// SecurityHeaders middleware sets the response headers hardening browsers against
+// MIME sniffing, clickjacking, referrer leaks and protocol downgrades.
+func SecurityHeaders(config SecurityHeadersConfig) gin.HandlerFunc {
+	var hsts string
+
+	if config.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(int(config.HSTSMaxAge.Seconds()))
+		if config.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+
+	return func(gctx *gin.Context) {
+		header := gctx.Writer.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+
+		if config.FrameOptions != "" {
+			header.Set("X-Frame-Options", config.FrameOptions)
+		}
+
+		if config.ReferrerPolicy != "" {
+			header.Set("Referrer-Policy", config.ReferrerPolicy)
+		}
+
+		if hsts != "" {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+
+		gctx.Next()
+	}
+}
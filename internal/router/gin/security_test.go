@@ -0,0 +1,151 @@
+package gin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/dto"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+func TestCORS(t *testing.T) {
+	t.Parallel()
+
+	controller, err := New(Config{
+		Mode: gin.TestMode,
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"https://admin.example.com"},
+			AllowedMethods: DefaultCORSAllowedMethods,
+			AllowedHeaders: DefaultCORSAllowedHeaders,
+			ExposedHeaders: DefaultCORSExposedHeaders,
+			MaxAge:         time.Hour,
+		},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	serve := func(method, origin string, header http.Header) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, "/health", nil)
+		request.Header = header
+		request.Header.Set("Origin", origin)
+
+		recorder := httptest.NewRecorder()
+		controller.Handler().ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	preflight := serve(http.MethodOptions, "https://admin.example.com", http.Header{
+		"Access-Control-Request-Method":  {http.MethodPut},
+		"Access-Control-Request-Headers": {"content-type,idempotency-key"},
+	})
+	assert.Equal(t, http.StatusNoContent, preflight.Code)
+	assert.Equal(t, "https://admin.example.com", preflight.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, http.MethodPut, preflight.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "3600", preflight.Header().Get("Access-Control-Max-Age"))
+
+	actual := serve(http.MethodGet, "https://admin.example.com", http.Header{})
+	assert.Equal(t, http.StatusOK, actual.Code)
+	assert.Equal(t, "https://admin.example.com", actual.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, actual.Header().Get("Access-Control-Expose-Headers"), http.CanonicalHeaderKey(RequestIDHeader))
+
+	// Other origins get no CORS headers.
+	other := serve(http.MethodGet, "https://evil.example.com", http.Header{})
+	assert.Empty(t, other.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	t.Parallel()
+
+	controller, err := New(Config{
+		Mode: gin.TestMode,
+		SecurityHeaders: SecurityHeadersConfig{
+			Enable:                true,
+			HSTSMaxAge:            DefaultHSTSMaxAge,
+			HSTSIncludeSubdomains: true,
+			FrameOptions:          DefaultFrameOptions,
+			ReferrerPolicy:        DefaultReferrerPolicy,
+		},
+	}, nil, nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	controller.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+	assert.Equal(t, "DENY", recorder.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "no-referrer", recorder.Header().Get("Referrer-Policy"))
+	assert.Equal(t, "max-age=31536000; includeSubDomains", recorder.Header().Get("Strict-Transport-Security"))
+}
+
+func TestMaxBodySize(t *testing.T) {
+	t.Parallel()
+
+	controller, err := New(Config{Mode: gin.TestMode, MaxBodySize: 64}, nil, nil)
+	require.NoError(t, err)
+
+	body := fmt.Sprintf(`{"firstName":%q}`, strings.Repeat("a", 64))
+
+	for name, contentLength := range map[string]int64{
+		"declared": int64(len(body)),
+		"chunked":  -1,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			request := httptest.NewRequest(http.MethodPost, "/api/v1/user", strings.NewReader(body))
+			request.Header.Set("Content-Type", "application/json")
+			request.ContentLength = contentLength
+
+			recorder := httptest.NewRecorder()
+			controller.Handler().ServeHTTP(recorder, request)
+
+			var problem dto.Problem
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+			assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+			assert.Equal(t, dto.CodeRequestTooLarge, problem.Code)
+		})
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	var deadline time.Time
+
+	mockDomain := domain.MockContract{}
+	mockDomain.On("Get", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			ctx, _ := args.Get(0).(context.Context)
+			deadline, _ = ctx.Deadline()
+			<-ctx.Done()
+		}).
+		Return(nil, context.DeadlineExceeded)
+
+	controller, err := New(Config{
+		Mode:    gin.TestMode,
+		Timeout: time.Hour,
+		Routes:  []RouteTimeoutConfig{{Route: "GET /api/v1/user/:id", Timeout: 10 * time.Millisecond}},
+	}, &mockDomain, nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	controller.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/v1/user/"+uuid.NewString(), nil))
+
+	var problem dto.Problem
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, dto.CodeRequestTimeout, problem.Code)
+	assert.WithinDuration(t, time.Now(), deadline, time.Second)
+}
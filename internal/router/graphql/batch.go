@@ -0,0 +1,133 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/handler"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	ErrBatchingDisabled  = errors.New("batching is disabled")
+	ErrTooManyOperations = errors.New("batch has too many operations")
+)
+
+// isBatch reports whether a request carries a JSON array of operations.
+func isBatch(request *http.Request, body []byte) bool {
+	contentType, _, _ := strings.Cut(request.Header.Get("Content-Type"), ";")
+
+	return request.Method == http.MethodPost &&
+		strings.TrimSpace(contentType) == handler.ContentTypeJSON &&
+		bytes.HasPrefix(bytes.TrimSpace(body), []byte("["))
+}
+
+// serveBatch executes a JSON array of operations, concurrently up to the
+// configured limit, and responds with the array of results in request order.
+// The operations share the complexity and alias budget of a single request,
+// and each takes a rate limit token.
+func (c *Controller) serveBatch(writer http.ResponseWriter, request *http.Request, body []byte) {
+	if !c.config.Batching.Enable {
+		writeErrors(writer, http.StatusBadRequest, ErrBatchingDisabled)
+
+		return
+	}
+
+	var operations []operationPayload
+	if err := json.Unmarshal(body, &operations); err != nil {
+		writeErrors(writer, http.StatusBadRequest, fmt.Errorf("batch unmarshal: %w", err))
+
+		return
+	}
+
+	if limit := c.config.Batching.MaxOperations; limit > 0 && len(operations) > limit {
+		writeErrors(writer, http.StatusBadRequest,
+			fmt.Errorf("%w: %d operations exceed the limit of %d", ErrTooManyOperations, len(operations), limit))
+
+		return
+	}
+
+	// The request took a token already.
+	if c.limiter != nil && len(operations) > 1 && !c.allow(writer, request, len(operations)-1) {
+		return
+	}
+
+	prepared := make([]error, len(operations))
+
+	var cost queryCost
+
+	for i := range operations {
+		var operationCost queryCost
+
+		operationCost, prepared[i] = c.preparePayload(&operations[i])
+		cost = cost.add(operationCost)
+	}
+
+	if err := c.checkBudget(cost); err != nil {
+		writeErrors(writer, http.StatusBadRequest, fmt.Errorf("batch: %w", err))
+
+		return
+	}
+
+	if c.limiter != nil && !c.allowFields(writer, request, operations...) {
+		return
+	}
+
+	results := make([]*graphql.Result, len(operations))
+
+	var group errgroup.Group
+	if c.config.Batching.Concurrency > 0 {
+		group.SetLimit(c.config.Batching.Concurrency)
+	}
+
+	for i, operation := range operations {
+		group.Go(func() error {
+			if prepared[i] != nil {
+				results[i] = &graphql.Result{Errors: formatErrors(prepared[i])}
+			} else {
+				results[i] = c.execute(request.Context(), operation)
+			}
+
+			return nil
+		})
+	}
+
+	_ = group.Wait()
+
+	var (
+		response []byte
+		err      error
+	)
+
+	if c.config.Pretty {
+		response, err = json.MarshalIndent(results, "", "\t")
+	} else {
+		response, err = json.Marshal(results)
+	}
+
+	if err != nil {
+		writeErrors(writer, http.StatusInternalServerError, fmt.Errorf("batch marshal: %w", err))
+
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = writer.Write(response)
+}
+
+// execute runs a prepared operation of a batch with its own user loader.
+func (c *Controller) execute(ctx context.Context, operation operationPayload) *graphql.Result {
+	return graphql.Do(graphql.Params{
+		Schema:         c.schema,
+		RequestString:  operation.Query,
+		VariableValues: operation.Variables,
+		OperationName:  operation.OperationName,
+		Context:        withUserLoader(ctx, c.domain),
+	})
+}
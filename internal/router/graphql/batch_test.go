@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+func TestController_Batch(t *testing.T) {
+	t.Parallel()
+
+	batch := `[` +
+		`{"query":"{ __typename }"},` +
+		`{"query":"query Name { __typename }","operationName":"Name"},` +
+		`{"query":"{ unknown }"},` +
+		`{"extensions":{"persistedQuery":{"version":1,"sha256Hash":"` + queryHash("{ unknown }") + `"}}}` +
+		`]`
+
+	tests := []struct {
+		name     string
+		config   BatchingConfig
+		status   int
+		expected string
+	}{
+		{
+			name:   "results in request order",
+			config: BatchingConfig{Enable: true, MaxOperations: 4, Concurrency: 2},
+			status: http.StatusOK,
+			expected: `[` +
+				`{"data":{"__typename":"Query"}},` +
+				`{"data":{"__typename":"Query"}},` +
+				`{"data":null,"errors":[{"message":"Cannot query field \"unknown\" on type \"Query\".",` +
+				`"locations":[{"line":1,"column":3}]}]},` +
+				`{"data":null,"errors":[{"message":"PersistedQueryNotFound","locations":[],` +
+				`"extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}` +
+				`]`,
+		},
+		{
+			name:     "too many operations",
+			config:   BatchingConfig{Enable: true, MaxOperations: 3},
+			status:   http.StatusBadRequest,
+			expected: `{"errors":[{"message":"batch has too many operations: 4 operations exceed the limit of 3","locations":[]}]}`,
+		},
+		{
+			name:     "disabled",
+			status:   http.StatusBadRequest,
+			expected: `{"errors":[{"message":"batching is disabled","locations":[]}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller, err := New(Config{
+				Batching:         test.config,
+				PersistedQueries: PersistedQueriesConfig{Enable: true, CacheSize: DefaultPersistedQueriesCacheSize},
+			}, nil, nil)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, DefaultPath, strings.NewReader(batch))
+			request.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+			controller.Handler().ServeHTTP(recorder, request)
+
+			assert.Equal(t, test.status, recorder.Code)
+			assert.JSONEq(t, test.expected, recorder.Body.String())
+		})
+	}
+}
+
+func TestController_BatchBudget(t *testing.T) {
+	t.Parallel()
+
+	// Each operation costs 2010, within the limit on its own.
+	operation := `{"query":"{ users(page: 1, limit: 1000) { users { id } } }"}`
+
+	tests := []struct {
+		name     string
+		limits   LimitsConfig
+		batch    string
+		expected string
+	}{
+		{
+			name:   "complexity",
+			limits: LimitsConfig{MaxComplexity: 3000},
+			batch:  `[` + operation + `,` + operation + `]`,
+			expected: `{"errors":[{"message":"batch: query is too complex: ` +
+				`complexity 4020 exceeds the limit of 3000","locations":[]}]}`,
+		},
+		{
+			name:   "aliases",
+			limits: LimitsConfig{MaxAliases: 1},
+			batch:  `[{"query":"{ a: user(id: \"1\") { id } }"},{"query":"{ b: user(id: \"2\") { id } }"}]`,
+			expected: `{"errors":[{"message":"batch: query has too many aliases: ` +
+				`2 aliases exceed the limit of 1","locations":[]}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller, err := New(Config{
+				Batching:      BatchingConfig{Enable: true},
+				Limits:        test.limits,
+				Introspection: true,
+			}, nil, nil)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodPost, DefaultPath, strings.NewReader(test.batch))
+			request.Header.Set("Content-Type", "application/json")
+
+			recorder := httptest.NewRecorder()
+			controller.Handler().ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.JSONEq(t, test.expected, recorder.Body.String())
+		})
+	}
+}
+
+func TestController_BatchRateLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(ratelimit.Config{Key: ratelimit.KeyIP, Rate: 1, Burst: 3})
+	require.NoError(t, err)
+
+	controller, err := New(Config{Batching: BatchingConfig{Enable: true}}, nil, nil, WithRateLimiter(limiter))
+	require.NoError(t, err)
+
+	serve := func(batch string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, DefaultPath, strings.NewReader(batch))
+		request.Header.Set("Content-Type", "application/json")
+
+		recorder := httptest.NewRecorder()
+		controller.Handler().ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	allowed := serve(`[{"query":"{ __typename }"},{"query":"{ __typename }"}]`)
+	assert.Equal(t, http.StatusOK, allowed.Code)
+	assert.Equal(t, "1", allowed.Header().Get("RateLimit-Remaining"))
+
+	limited := serve(`[{"query":"{ __typename }"},{"query":"{ __typename }"}]`)
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Contains(t, limited.Body.String(), `"code":"RATE_LIMITED"`)
+}
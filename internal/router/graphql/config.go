@@ -1,4 +1,109 @@
 package graphql
 
-// TOFO: Add config fields if needed.
-type Config struct{}
+import "time"
+
+type Config struct {
+	// Path of the GraphQL endpoint, the SDL is served at Path/schema.
+	Path string `yaml:"path" mapstructure:"Path"`
+	// GraphiQL and Playground are served to browsers on GET requests.
+	GraphiQL   bool `yaml:"graphiql" mapstructure:"GraphiQL"`
+	Playground bool `yaml:"playground" mapstructure:"Playground"`
+	// Introspection enables __schema and __type queries and the SDL endpoint.
+	Introspection bool `yaml:"introspection" mapstructure:"Introspection"`
+	// Pretty prints responses.
+	Pretty bool `yaml:"pretty" mapstructure:"Pretty"`
+	// TrustedProxies are the IPs and CIDR ranges of reverse proxies whose X-Forwarded-For
+	// header identifies clients, none by default: clients are identified by the remote address.
+	TrustedProxies []string `yaml:"trustedProxies" mapstructure:"TrustedProxies"`
+
+	Batching         BatchingConfig         `yaml:"batching" mapstructure:"Batching"`
+	Limits           LimitsConfig           `yaml:"limits" mapstructure:"Limits"`
+	PersistedQueries PersistedQueriesConfig `yaml:"persistedQueries" mapstructure:"PersistedQueries"`
+	Subscriptions    SubscriptionsConfig    `yaml:"subscriptions" mapstructure:"Subscriptions"`
+}
+
+// BatchingConfig of requests carrying a JSON array of operations.
+type BatchingConfig struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// MaxOperations in a batch, 0 - unlimited.
+	MaxOperations int `yaml:"maxOperations" mapstructure:"MaxOperations"`
+	// Concurrency of operations of a batch, 0 - unlimited.
+	Concurrency int `yaml:"concurrency" mapstructure:"Concurrency"`
+}
+
+// LimitsConfig bounds the cost of requests, checked before execution. Zero disables a limit.
+type LimitsConfig struct {
+	MaxDepth int `yaml:"maxDepth" mapstructure:"MaxDepth"`
+	// MaxComplexity is the budget for the sum of field costs,
+	// selections of paged fields are weighted by the page size.
+	MaxComplexity int `yaml:"maxComplexity" mapstructure:"MaxComplexity"`
+	MaxAliases    int `yaml:"maxAliases" mapstructure:"MaxAliases"`
+	// MaxBodySize of requests in bytes.
+	MaxBodySize int64 `yaml:"maxBodySize" mapstructure:"MaxBodySize"`
+}
+
+// PersistedQueriesConfig of automatic persisted queries.
+type PersistedQueriesConfig struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// CacheSize is the number of queries registered by clients kept in memory.
+	CacheSize int `yaml:"cacheSize" mapstructure:"CacheSize"`
+	// Allowlist only executes the queries of the manifest.
+	Allowlist bool `yaml:"allowlist" mapstructure:"Allowlist"`
+	// Manifest is the path of an Apollo persisted query manifest.
+	Manifest string `yaml:"manifest" mapstructure:"Manifest"`
+}
+
+// SubscriptionsConfig configures subscriptions over the graphql-transport-ws protocol.
+type SubscriptionsConfig struct {
+	Enable bool `yaml:"enable" mapstructure:"Enable"`
+	// InitTimeout bounds the wait for the connection_init message.
+	InitTimeout time.Duration `yaml:"initTimeout" mapstructure:"InitTimeout"`
+	// PingInterval between server pings, 0 disables them.
+	PingInterval time.Duration `yaml:"pingInterval" mapstructure:"PingInterval"`
+	// MaxOperations running at once on a connection, 0 - unlimited.
+	MaxOperations int `yaml:"maxOperations" mapstructure:"MaxOperations"`
+}
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"GraphQL.Path":                        DefaultPath,
+		"GraphQL.GraphiQL":                    true,
+		"GraphQL.Playground":                  false,
+		"GraphQL.Introspection":               true,
+		"GraphQL.Pretty":                      true,
+		"GraphQL.TrustedProxies":              []string{},
+		"GraphQL.Batching.Enable":             true,
+		"GraphQL.Batching.MaxOperations":      DefaultMaxOperations,
+		"GraphQL.Batching.Concurrency":        DefaultBatchConcurrency,
+		"GraphQL.Limits.MaxDepth":             DefaultMaxDepth,
+		"GraphQL.Limits.MaxComplexity":        DefaultMaxComplexity,
+		"GraphQL.Limits.MaxAliases":           DefaultMaxAliases,
+		"GraphQL.Limits.MaxBodySize":          DefaultMaxBodySize,
+		"GraphQL.PersistedQueries.Enable":     true,
+		"GraphQL.PersistedQueries.CacheSize":  DefaultPersistedQueriesCacheSize,
+		"GraphQL.PersistedQueries.Allowlist":  false,
+		"GraphQL.PersistedQueries.Manifest":   "",
+		"GraphQL.Subscriptions.Enable":        false,
+		"GraphQL.Subscriptions.InitTimeout":   DefaultInitTimeout,
+		"GraphQL.Subscriptions.PingInterval":  DefaultPingInterval,
+		"GraphQL.Subscriptions.MaxOperations": DefaultMaxSubscriptionOperations,
+	}
+}
+
+const (
+	DefaultPath = "/api/v1/graphql"
+
+	DefaultMaxOperations    = 10
+	DefaultBatchConcurrency = 4
+
+	DefaultMaxDepth      = 10
+	DefaultMaxComplexity = 20000
+	DefaultMaxAliases    = 30
+	DefaultMaxBodySize   = 1 << 20
+
+	DefaultPersistedQueriesCacheSize = 1000
+
+	DefaultInitTimeout               = 10 * time.Second
+	DefaultPingInterval              = 30 * time.Second
+	DefaultMaxSubscriptionOperations = 100
+)
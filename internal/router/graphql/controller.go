@@ -1,22 +1,60 @@
 package graphql
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/gorilla/websocket"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/handler"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	gqlcontract "github.com/yolkhovyy/go-userv/contract/graphql"
 	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/health"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
 )
 
 type Controller struct {
-	domain  domain.Contract
-	handler *handler.Handler
+	domain     domain.Contract
+	subscriber Subscriber
+	config     Config
+	schema     graphql.Schema
+	handler    *handler.Handler
+	persisted  *persistedQueries
+	limiter    *ratelimit.Limiter
+	proxies    ratelimit.Proxies
+	health     *health.Registry
+	mux        *http.ServeMux
 }
 
-func New(_ Config, domain domain.Contract) (*Controller, error) {
+// Subscriber streams user change events until ctx is done.
+type Subscriber interface {
+	Subscribe(ctx context.Context) <-chan domain.UserEvent
+}
+
+// New creates the GraphQL router. The subscriber feeds subscriptions,
+// it may be nil when subscriptions are disabled.
+func New(config Config, domain domain.Contract, subscriber Subscriber, options ...Option) (*Controller, error) {
+	if config.Path == "" {
+		config.Path = DefaultPath
+	}
+
 	controller := Controller{
-		domain: domain,
+		domain:     domain,
+		subscriber: subscriber,
+		config:     config,
+		mux:        http.NewServeMux(),
+	}
+
+	for _, option := range options {
+		option(&controller)
+	}
+
+	if controller.health == nil {
+		controller.health = health.New(health.Config{})
 	}
 
 	schema, err := graphql.NewSchema(controller.schemaConfig())
@@ -24,17 +62,92 @@ func New(_ Config, domain domain.Contract) (*Controller, error) {
 		return nil, fmt.Errorf("new graphql router: %w", err)
 	}
 
+	if err := checkParity(schema, gqlcontract.Schema); err != nil {
+		return nil, fmt.Errorf("new graphql router: %w", err)
+	}
+
+	controller.schema = schema
+
+	if controller.proxies, err = ratelimit.ParseProxies(config.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("new graphql router: %w", err)
+	}
+
+	if config.PersistedQueries.Enable {
+		if controller.persisted, err = newPersistedQueries(config.PersistedQueries); err != nil {
+			return nil, fmt.Errorf("new graphql router: %w", err)
+		}
+	}
+
 	controller.handler = handler.New(&handler.Config{
-		Schema:   &schema,
-		Pretty:   true,
-		GraphiQL: true,
+		Schema:     &schema,
+		Pretty:     config.Pretty,
+		GraphiQL:   config.GraphiQL,
+		Playground: config.Playground,
 	})
 
-	http.Handle("/api/v1/graphql", controller.handler)
+	controller.mux.HandleFunc("GET /health", controller.serveHealth)
+	controller.mux.HandleFunc("GET "+health.LivenessPath, controller.health.ServeLivenessSummary)
+	controller.mux.HandleFunc("GET "+health.ReadinessPath, controller.health.ServeReadinessSummary)
+	controller.mux.Handle("GET /metrics", promhttp.Handler())
+	controller.mux.Handle(config.Path, &controller)
+
+	if config.Introspection {
+		controller.mux.HandleFunc("GET "+config.Path+"/schema", controller.serveSchema)
+	}
 
 	return &controller, nil
 }
 
+// Handler returns the router serving the GraphQL endpoint, health checks and metrics.
 func (c *Controller) Handler() http.Handler {
-	return c.handler
+	return requestid.Handler(c.mux)
+}
+
+// ServeHTTP serves subscriptions on WebSocket upgrade requests,
+// batches of operations on JSON arrays, queries and mutations otherwise.
+func (c *Controller) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if c.limiter != nil && !c.allow(writer, request, 1) {
+		return
+	}
+
+	if c.config.Subscriptions.Enable && websocket.IsWebSocketUpgrade(request) {
+		c.serveSubscriptions(writer, request)
+
+		return
+	}
+
+	// The schema has no Upload scalar, multipart requests are refused before reading their parts.
+	if isMultipart(request) {
+		writeErrors(writer, http.StatusUnsupportedMediaType, ErrMultipartUnsupported)
+
+		return
+	}
+
+	body, ok := c.readBody(writer, request)
+	if !ok {
+		return
+	}
+
+	if isBatch(request, body) {
+		c.serveBatch(writer, request, body)
+
+		return
+	}
+
+	if !c.prepare(writer, request, body) {
+		return
+	}
+
+	c.handler.ServeHTTP(writer, request.WithContext(withUserLoader(request.Context(), c.domain)))
+}
+
+func (c *Controller) serveHealth(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_, _ = io.WriteString(writer, `{"message":"healthy"}`)
+}
+
+// serveSchema serves the SDL the executable schema is checked against.
+func (c *Controller) serveSchema(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = io.WriteString(writer, gqlcontract.Schema)
 }
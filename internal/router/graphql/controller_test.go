@@ -0,0 +1,132 @@
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_Handler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		config      Config
+		method      string
+		target      string
+		accept      string
+		contentType string
+		status      int
+		contains    string
+	}{
+		{
+			name:     "health",
+			method:   http.MethodGet,
+			target:   "/health",
+			status:   http.StatusOK,
+			contains: "healthy",
+		},
+		{
+			name:     "metrics",
+			method:   http.MethodGet,
+			target:   "/metrics",
+			status:   http.StatusOK,
+			contains: "go_goroutines",
+		},
+		{
+			name:     "custom path",
+			config:   Config{Path: "/graphql"},
+			method:   http.MethodGet,
+			target:   "/graphql?query={__typename}",
+			status:   http.StatusOK,
+			contains: `{"data":{"__typename":"Query"}}`,
+		},
+		{
+			name:   "default path not served",
+			config: Config{Path: "/graphql"},
+			method: http.MethodGet,
+			target: DefaultPath + "?query={__typename}",
+			status: http.StatusNotFound,
+		},
+		{
+			name:     "mutation over GET",
+			method:   http.MethodGet,
+			target:   DefaultPath + `?query=mutation{delete(id:"1")}`,
+			status:   http.StatusMethodNotAllowed,
+			contains: ErrMutationOverGet.Error(),
+		},
+		{
+			name:        "multipart",
+			method:      http.MethodPost,
+			target:      DefaultPath,
+			contentType: "multipart/form-data; boundary=upload",
+			status:      http.StatusUnsupportedMediaType,
+			contains:    ErrMultipartUnsupported.Error(),
+		},
+		{
+			name:     "graphiql",
+			config:   Config{GraphiQL: true},
+			method:   http.MethodGet,
+			target:   DefaultPath,
+			accept:   "text/html",
+			status:   http.StatusOK,
+			contains: "graphiql",
+		},
+		{
+			name:     "graphiql disabled",
+			method:   http.MethodGet,
+			target:   DefaultPath,
+			accept:   "text/html",
+			status:   http.StatusOK,
+			contains: `"errors"`,
+		},
+		{
+			name:     "schema",
+			config:   Config{Introspection: true},
+			method:   http.MethodGet,
+			target:   DefaultPath + "/schema",
+			status:   http.StatusOK,
+			contains: "type Query {",
+		},
+		{
+			name:   "schema with introspection disabled",
+			method: http.MethodGet,
+			target: DefaultPath + "/schema",
+			status: http.StatusNotFound,
+		},
+		{
+			name:     "introspection disabled",
+			method:   http.MethodGet,
+			target:   DefaultPath + "?query={__schema{queryType{name}}}",
+			status:   http.StatusOK,
+			contains: ErrIntrospectionDisabled.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			controller, err := New(test.config, nil, nil)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(test.method, test.target, nil)
+			if test.accept != "" {
+				request.Header.Set("Accept", test.accept)
+			}
+
+			if test.contentType != "" {
+				request.Header.Set("Content-Type", test.contentType)
+			}
+
+			recorder := httptest.NewRecorder()
+			controller.Handler().ServeHTTP(recorder, request)
+
+			assert.Equal(t, test.status, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), test.contains)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	gqlcontract "github.com/yolkhovyy/go-userv/contract/graphql"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+// Apollo Federation v2 subgraph, see
+// https://www.apollographql.com/docs/federation/subgraph-spec.
+
+var ErrInvalidRepresentation = errors.New("invalid entity representation")
+
+// federationLink imports the federation directives used by the contract SDL.
+const federationLink = `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key"])` + "\n\n"
+
+//nolint:gochecknoglobals
+var anyScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "_Any",
+	Serialize:    func(value any) any { return value },
+	ParseValue:   func(value any) any { return value },
+	ParseLiteral: literalValue,
+})
+
+//nolint:gochecknoglobals
+var serviceType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "_Service",
+	Fields: graphql.Fields{
+		"sdl": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+//nolint:gochecknoglobals
+var entityType = graphql.NewUnion(graphql.UnionConfig{
+	Name:  "_Entity",
+	Types: []*graphql.Object{userType},
+})
+
+type service struct {
+	SDL string `json:"sdl"`
+}
+
+// federationFields are the Query fields of a subgraph.
+func (c *Controller) federationFields() graphql.Fields {
+	return graphql.Fields{
+		"_service": &graphql.Field{
+			Type: graphql.NewNonNull(serviceType),
+			Resolve: func(graphql.ResolveParams) (any, error) {
+				return service{SDL: federationLink + gqlcontract.Schema}, nil
+			},
+		},
+		"_entities": &graphql.Field{
+			Type: graphql.NewNonNull(graphql.NewList(entityType)),
+			Args: graphql.FieldConfigArgument{
+				"representations": &graphql.ArgumentConfig{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(anyScalar))),
+				},
+			},
+			Resolve: c.entities(),
+		},
+	}
+}
+
+// entities resolves User representations, keyed by id, with a single storage call.
+// Entities not found resolve to null.
+func (c *Controller) entities() graphql.FieldResolveFn {
+	return withTelemetry(func(params graphql.ResolveParams) (any, error) {
+		representations, _ := params.Args["representations"].([]any)
+
+		userIDs := make([]uuid.UUID, len(representations))
+
+		for i, representation := range representations {
+			userID, err := representationID(representation)
+			if err != nil {
+				return nil, fmt.Errorf("entities resolver representation %d: %w", i, err)
+			}
+
+			userIDs[i] = userID
+		}
+
+		users, err := c.domain.GetMany(params.Context, userIDs)
+		if err != nil {
+			return nil, fmt.Errorf("entities resolver: %w", err)
+		}
+
+		found := make(map[uuid.UUID]domain.User, len(users))
+		for _, user := range users {
+			found[user.ID] = user
+		}
+
+		entities := make([]any, len(userIDs))
+
+		for i, userID := range userIDs {
+			if user, ok := found[userID]; ok {
+				entities[i] = user
+			}
+		}
+
+		return entities, nil
+	})
+}
+
+// representationID returns the user id of a User representation.
+func representationID(representation any) (uuid.UUID, error) {
+	fields, ok := representation.(map[string]any)
+	if !ok {
+		return uuid.Nil, ErrInvalidRepresentation
+	}
+
+	if typeName, _ := fields["__typename"].(string); typeName != userNodeType {
+		return uuid.Nil, fmt.Errorf("typename %q: %w", fields["__typename"], ErrInvalidRepresentation)
+	}
+
+	userID, err := inputMap(fields).uuidValue("id")
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("id: %w", err)
+	}
+
+	return userID, nil
+}
+
+// literalValue converts an inline _Any value into its Go value.
+func literalValue(value ast.Value) any {
+	switch value := value.(type) {
+	case *ast.ObjectValue:
+		fields := make(map[string]any, len(value.Fields))
+		for _, field := range value.Fields {
+			fields[field.Name.Value] = literalValue(field.Value)
+		}
+
+		return fields
+	case *ast.ListValue:
+		values := make([]any, len(value.Values))
+		for i, item := range value.Values {
+			values[i] = literalValue(item)
+		}
+
+		return values
+	case *ast.IntValue:
+		number, _ := strconv.Atoi(value.Value)
+
+		return number
+	case *ast.FloatValue:
+		number, _ := strconv.ParseFloat(value.Value, 64)
+
+		return number
+	case *ast.BooleanValue:
+		return value.Value
+	case *ast.StringValue:
+		return value.Value
+	case *ast.EnumValue:
+		return value.Value
+	}
+
+	return nil
+}
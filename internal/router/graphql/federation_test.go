@@ -0,0 +1,42 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+func TestController_Federation(t *testing.T) {
+	t.Parallel()
+
+	user := domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+	unknownID := uuid.New()
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		GetMany(mock.Anything, []uuid.UUID{user.ID, unknownID}).
+		Return([]domain.User{user}, nil).
+		Once()
+
+	controller, err := New(Config{}, &mockDomain, nil)
+	require.NoError(t, err)
+
+	response := post(t, controller, `{"query":"{ _service { sdl } }"}`)
+	assert.Contains(t, response, `extend schema @link(url: \"https://specs.apollo.dev/federation/v2.3\"`)
+	assert.Contains(t, response, `type User implements Node @key(fields: \"id\")`)
+
+	// Representations resolve in order through a single storage call.
+	response = post(t, controller, `{"query":"query($representations: [_Any!]!) { _entities(representations: $representations) { ... on User { id email } } }",`+
+		`"variables":{"representations":[`+
+		`{"__typename":"User","id":"`+user.ID.String()+`"},`+
+		`{"__typename":"User","id":"`+unknownID.String()+`"}]}}`)
+	assert.JSONEq(t,
+		`{"data":{"_entities":[{"id":"`+user.ID.String()+`","email":"john.doe@example.com"},null]}}`,
+		response)
+
+	mockDomain.AssertExpectations(t)
+}
@@ -0,0 +1,394 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+var (
+	ErrQueryTooDeep    = errors.New("query is too deep")
+	ErrQueryTooComplex = errors.New("query is too complex")
+	ErrTooManyAliases  = errors.New("query has too many aliases")
+	ErrRequestTooLarge = errors.New("request is too large")
+
+	ErrIntrospectionDisabled = errors.New("introspection is disabled")
+)
+
+// fieldCosts are the static costs of fields reaching storage, keyed by
+// parent type and field name. Other fields cost defaultFieldCost.
+//
+//nolint:gochecknoglobals
+var fieldCosts = map[string]int{
+	"Query.user":            5,
+	"Query.node":            5,
+	"Query.users":           10,
+	"Query.usersConnection": 10,
+	"Query._entities":       10,
+	"Mutation.create":       10,
+	"Mutation.update":       10,
+	"Mutation.delete":       10,
+}
+
+const defaultFieldCost = 1
+
+// listSizeArguments weigh the selections of a field returning a page of users,
+// by their value or, for lists, their length.
+//
+//nolint:gochecknoglobals
+var listSizeArguments = []string{"limit", "first", "last", "representations"}
+
+// defaultListSizes apply to list fields called without a size argument.
+//
+//nolint:gochecknoglobals
+var defaultListSizes = map[string]int{
+	"Query.users":           defaultUsersLimit,
+	"Query.usersConnection": defaultConnectionSize,
+}
+
+// unresolvedListSize is charged for size arguments whose value is unknown
+// before execution, such as variables neither provided nor defaulted.
+const unresolvedListSize = maxConnectionSize
+
+// checkLimits rejects queries exceeding the configured depth, complexity
+// and alias limits, queries failing to parse, and introspection queries when disabled.
+// It returns the cost of the query, summed over its operations.
+func (c *Controller) checkLimits(query string, variables map[string]any) (queryCost, error) {
+	limits := c.config.Limits
+	if limits.MaxDepth <= 0 && limits.MaxComplexity <= 0 && limits.MaxAliases <= 0 && c.config.Introspection {
+		return queryCost{}, nil
+	}
+
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return queryCost{}, err //nolint:wrapcheck
+	}
+
+	analyzer := queryAnalyzer{
+		schema:        &c.schema,
+		variables:     variables,
+		maxComplexity: limits.MaxComplexity,
+		fragments:     make(map[string]*ast.FragmentDefinition),
+		fragmentCosts: make(map[string]queryCost),
+		expanding:     make(map[string]bool),
+	}
+
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			analyzer.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var total queryCost
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		var root *graphql.Object
+
+		switch operation.Operation {
+		case ast.OperationTypeQuery:
+			root = c.schema.QueryType()
+		case ast.OperationTypeMutation:
+			root = c.schema.MutationType()
+		case ast.OperationTypeSubscription:
+			root = c.schema.SubscriptionType()
+		}
+
+		if root == nil {
+			continue
+		}
+
+		analyzer.operation(operation)
+
+		cost := analyzer.selectionSet(root, operation.SelectionSet)
+		total = total.add(cost)
+
+		switch {
+		case limits.MaxComplexity > 0 && cost.complexity > limits.MaxComplexity:
+			return total, fmt.Errorf("%w: complexity %d exceeds the limit of %d",
+				ErrQueryTooComplex, cost.complexity, limits.MaxComplexity)
+		case limits.MaxDepth > 0 && cost.depth > limits.MaxDepth:
+			return total, fmt.Errorf("%w: depth %d exceeds the limit of %d", ErrQueryTooDeep, cost.depth, limits.MaxDepth)
+		}
+	}
+
+	if analyzer.introspection && !c.config.Introspection {
+		return total, ErrIntrospectionDisabled
+	}
+
+	if limits.MaxAliases > 0 && total.aliases > limits.MaxAliases {
+		return total, fmt.Errorf("%w: %d aliases exceed the limit of %d",
+			ErrTooManyAliases, total.aliases, limits.MaxAliases)
+	}
+
+	return total, nil
+}
+
+// checkBudget rejects a batch whose operations together exceed the complexity
+// or alias limit of a single request.
+func (c *Controller) checkBudget(cost queryCost) error {
+	limits := c.config.Limits
+
+	switch {
+	case limits.MaxComplexity > 0 && cost.complexity > limits.MaxComplexity:
+		return fmt.Errorf("%w: complexity %d exceeds the limit of %d",
+			ErrQueryTooComplex, cost.complexity, limits.MaxComplexity)
+	case limits.MaxAliases > 0 && cost.aliases > limits.MaxAliases:
+		return fmt.Errorf("%w: %d aliases exceed the limit of %d",
+			ErrTooManyAliases, cost.aliases, limits.MaxAliases)
+	}
+
+	return nil
+}
+
+// queryCost is the complexity, depth and alias count of a selection set.
+type queryCost struct {
+	complexity int
+	depth      int
+	aliases    int
+}
+
+func (q queryCost) add(other queryCost) queryCost {
+	return queryCost{
+		complexity: saturatingAdd(q.complexity, other.complexity),
+		depth:      max(q.depth, other.depth),
+		aliases:    saturatingAdd(q.aliases, other.aliases),
+	}
+}
+
+type queryAnalyzer struct {
+	schema    *graphql.Schema
+	variables map[string]any
+	// defaults are the default values of the variables of the current operation.
+	defaults map[string]ast.Value
+	// maxComplexity stops the walk once exceeded, zero walks the whole query.
+	maxComplexity int
+	fragments     map[string]*ast.FragmentDefinition
+	// fragmentCosts are computed once per fragment, whatever the number of spreads.
+	fragmentCosts map[string]queryCost
+	// expanding are the fragments on the current path, spreading them again is a cycle.
+	expanding map[string]bool
+	// introspection is set by __schema and __type fields, __typename is allowed.
+	introspection bool
+}
+
+// operation prepares the walk of an operation, fragment costs depend on
+// the variable defaults of the operation spreading them.
+func (a *queryAnalyzer) operation(operation *ast.OperationDefinition) {
+	a.defaults = make(map[string]ast.Value, len(operation.VariableDefinitions))
+	for _, definition := range operation.VariableDefinitions {
+		if definition.DefaultValue != nil {
+			a.defaults[definition.Variable.Name.Value] = definition.DefaultValue
+		}
+	}
+
+	clear(a.fragmentCosts)
+}
+
+// selectionSet returns the cost of a selection set. Introspection fields are
+// not counted. The walk stops early once the complexity exceeds the limit,
+// costs never decrease with more selections.
+func (a *queryAnalyzer) selectionSet(parent graphql.Named, set *ast.SelectionSet) queryCost {
+	var cost queryCost
+
+	if set == nil {
+		return cost
+	}
+
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if name := selection.Name.Value; strings.HasPrefix(name, "__") {
+				a.introspection = a.introspection || name != "__typename"
+
+				continue
+			}
+
+			fieldCost := a.field(parent, selection)
+			if selection.Alias != nil {
+				fieldCost.aliases = saturatingAdd(fieldCost.aliases, 1)
+			}
+
+			cost = cost.add(fieldCost)
+
+		case *ast.InlineFragment:
+			fragmentType := parent
+			if selection.TypeCondition != nil {
+				fragmentType = a.schema.Type(selection.TypeCondition.Name.Value)
+			}
+
+			cost = cost.add(a.selectionSet(fragmentType, selection.SelectionSet))
+
+		case *ast.FragmentSpread:
+			cost = cost.add(a.fragment(selection.Name.Value))
+		}
+
+		if a.maxComplexity > 0 && cost.complexity > a.maxComplexity {
+			break
+		}
+	}
+
+	return cost
+}
+
+// fragment returns the cost of a named fragment, cycles cost nothing,
+// they are reported by the executor.
+func (a *queryAnalyzer) fragment(name string) queryCost {
+	if cost, ok := a.fragmentCosts[name]; ok {
+		return cost
+	}
+
+	fragment, ok := a.fragments[name]
+	if !ok || a.expanding[name] {
+		return queryCost{}
+	}
+
+	a.expanding[name] = true
+	cost := a.selectionSet(a.schema.Type(fragment.TypeCondition.Name.Value), fragment.SelectionSet)
+	delete(a.expanding, name)
+
+	a.fragmentCosts[name] = cost
+
+	return cost
+}
+
+func (a *queryAnalyzer) field(parent graphql.Named, field *ast.Field) queryCost {
+	var (
+		fieldType  graphql.Named
+		parentName string
+	)
+
+	switch parent := parent.(type) {
+	case *graphql.Object:
+		parentName = parent.Name()
+		if definition, ok := parent.Fields()[field.Name.Value]; ok {
+			fieldType = graphql.GetNamed(definition.Type)
+		}
+	case *graphql.Interface:
+		parentName = parent.Name()
+		if definition, ok := parent.Fields()[field.Name.Value]; ok {
+			fieldType = graphql.GetNamed(definition.Type)
+		}
+	}
+
+	cost, ok := fieldCosts[parentName+"."+field.Name.Value]
+	if !ok {
+		cost = defaultFieldCost
+	}
+
+	size, ok := a.listSize(field)
+	if !ok {
+		size = defaultListSizes[parentName+"."+field.Name.Value]
+	}
+
+	selections := a.selectionSet(fieldType, field.SelectionSet)
+
+	return queryCost{
+		complexity: saturatingAdd(cost, saturatingMul(max(size, 1), selections.complexity)),
+		depth:      selections.depth + 1,
+		aliases:    selections.aliases,
+	}
+}
+
+// maxCost bounds the computed costs, so that they never overflow.
+const maxCost = math.MaxInt32
+
+func saturatingAdd(a, b int) int {
+	return min(a+b, maxCost)
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > maxCost/a {
+		return maxCost
+	}
+
+	return min(a*b, maxCost)
+}
+
+// listSize returns the page size requested by the field arguments, sizes
+// unknown before execution are charged unresolvedListSize.
+func (a *queryAnalyzer) listSize(field *ast.Field) (int, bool) {
+	for _, argument := range field.Arguments {
+		for _, name := range listSizeArguments {
+			if argument.Name.Value != name {
+				continue
+			}
+
+			size, ok := a.intValue(argument.Value)
+			if !ok {
+				return unresolvedListSize, true
+			}
+
+			if size > 0 {
+				return size, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// intValue returns the value of an integer or the length of a list, variables
+// are resolved from the request, then from their defaults.
+func (a *queryAnalyzer) intValue(value ast.Value) (int, bool) {
+	switch value := value.(type) {
+	case *ast.IntValue:
+		size, err := strconv.Atoi(value.Value)
+
+		return size, err == nil
+	case *ast.ListValue:
+		return len(value.Values), true
+	case *ast.Variable:
+		name := value.Name.Value
+
+		switch size := a.variables[name].(type) {
+		case int:
+			return size, true
+		case float64:
+			return int(min(size, maxCost)), true
+		case []any:
+			return len(size), true
+		case nil:
+			if defaultValue, ok := a.defaults[name]; ok {
+				return a.intValue(defaultValue)
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// writeErrors writes a GraphQL response carrying only the error, without data.
+func writeErrors(writer http.ResponseWriter, status int, err error) {
+	writer.Header().Set("Content-Type", "application/json; charset=utf-8")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(struct {
+		Errors []gqlerrors.FormattedError `json:"errors"`
+	}{formatErrors(err)})
+}
+
+// formatErrors formats an error keeping its extensions.
+func formatErrors(err error) []gqlerrors.FormattedError {
+	formatted := gqlerrors.FormatError(err)
+
+	var extended gqlerrors.ExtendedError
+	if errors.As(err, &extended) {
+		formatted.Extensions = extended.Extensions()
+	}
+
+	return []gqlerrors.FormattedError{formatted}
+}
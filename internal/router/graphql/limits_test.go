@@ -0,0 +1,327 @@
+package graphql
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+func TestController_CheckLimits(t *testing.T) {
+	t.Parallel()
+
+	var controller Controller
+
+	schema, err := graphql.NewSchema(controller.schemaConfig())
+	require.NoError(t, err)
+
+	controller.schema = schema
+
+	tests := []struct {
+		name      string
+		limits    LimitsConfig
+		query     string
+		variables map[string]any
+		// noIntrospection disables introspection.
+		noIntrospection bool
+		expected        error
+	}{
+		{
+			name:   "within limits",
+			limits: LimitsConfig{MaxDepth: 3, MaxComplexity: 3010, MaxAliases: 1},
+			query:  `{ users(page: 1, limit: 1000) { users { id email } } }`,
+		},
+		{
+			name:     "too complex",
+			limits:   LimitsConfig{MaxComplexity: 3010},
+			query:    `{ users(page: 1, limit: 1000) { users { id email country } } }`,
+			expected: ErrQueryTooComplex,
+		},
+		{
+			name:      "too complex with variables",
+			limits:    LimitsConfig{MaxComplexity: 3010},
+			query:     `query($limit: Int!) { users(page: 1, limit: $limit) { users { id email country } } }`,
+			variables: map[string]any{"limit": float64(1000)},
+			expected:  ErrQueryTooComplex,
+		},
+		{
+			name:     "too complex with variable default",
+			limits:   LimitsConfig{MaxComplexity: 2000},
+			query:    `query($n: Int = 1000) { usersConnection(first: $n) { edges { node { id email } } } }`,
+			expected: ErrQueryTooComplex,
+		},
+		{
+			name:      "variable overrides its default",
+			limits:    LimitsConfig{MaxComplexity: 2000},
+			query:     `query($n: Int = 1000) { usersConnection(first: $n) { edges { node { id email } } } }`,
+			variables: map[string]any{"n": float64(1)},
+		},
+		{
+			name:     "too complex with unresolved size",
+			limits:   LimitsConfig{MaxComplexity: 2000},
+			query:    `query($n: Int) { usersConnection(first: $n) { edges { node { id email } } } }`,
+			expected: ErrQueryTooComplex,
+		},
+		{
+			name:     "too complex with default connection size",
+			limits:   LimitsConfig{MaxComplexity: 39},
+			query:    `{ usersConnection { edges { node { id } } } }`,
+			expected: ErrQueryTooComplex,
+		},
+		{
+			name:     "too complex with default users limit",
+			limits:   LimitsConfig{MaxComplexity: 49},
+			query:    `{ users(page: 1) { users { id email country } } }`,
+			expected: ErrQueryTooComplex,
+		},
+		{
+			name:     "too deep",
+			limits:   LimitsConfig{MaxDepth: 3},
+			query:    `{ usersConnection(first: 1) { edges { node { id } } } }`,
+			expected: ErrQueryTooDeep,
+		},
+		{
+			name:     "too deep with fragments",
+			limits:   LimitsConfig{MaxDepth: 3},
+			query:    `{ usersConnection(first: 1) { edges { ...edge } } } fragment edge on UserEdge { node { id } }`,
+			expected: ErrQueryTooDeep,
+		},
+		{
+			name:   "inline fragments add no depth",
+			limits: LimitsConfig{MaxDepth: 2},
+			query:  `{ node(id: "VXNlcjo=") { ... on User { id } } }`,
+		},
+		{
+			name:     "too many aliases",
+			limits:   LimitsConfig{MaxAliases: 2},
+			query:    `{ a: user(id: "1") { id } b: user(id: "2") { id } c: user(id: "3") { id } }`,
+			expected: ErrTooManyAliases,
+		},
+		{
+			name:            "introspection disabled",
+			query:           `{ users(page: 1, limit: 1) { totalCount } __type(name: "User") { name } }`,
+			noIntrospection: true,
+			expected:        ErrIntrospectionDisabled,
+		},
+		{
+			name:            "typename with introspection disabled",
+			query:           `{ __typename }`,
+			noIntrospection: true,
+		},
+		{
+			name:   "introspection is not counted",
+			limits: LimitsConfig{MaxDepth: 1, MaxComplexity: 1},
+			query:  `{ __schema { types { name fields { name type { name ofType { name } } } } } }`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			limited := controller
+			limited.config.Limits = test.limits
+			limited.config.Introspection = !test.noIntrospection
+
+			_, err := limited.checkLimits(test.query, test.variables)
+			if test.expected == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestController_CheckLimitsFragments(t *testing.T) {
+	t.Parallel()
+
+	var controller Controller
+
+	schema, err := graphql.NewSchema(controller.schemaConfig())
+	require.NoError(t, err)
+
+	controller.schema = schema
+	controller.config.Introspection = true
+
+	// Each fragment spreads the next one twice, walking
+	// every spread would take 2^26 steps.
+	var query strings.Builder
+
+	query.WriteString(`{ users(page: 1, limit: 1000) { users { ...f0 } } }`)
+
+	const fragments = 26
+	for i := range fragments - 1 {
+		fmt.Fprintf(&query, " fragment f%d on User { ...f%d ...f%d }", i, i+1, i+1)
+	}
+
+	fmt.Fprintf(&query, " fragment f%d on User { id }", fragments-1)
+
+	tests := []struct {
+		name     string
+		limits   LimitsConfig
+		expected error
+	}{
+		{name: "complexity", limits: LimitsConfig{MaxComplexity: 3010}, expected: ErrQueryTooComplex},
+		{name: "depth only", limits: LimitsConfig{MaxDepth: 3}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			limited := controller
+			limited.config.Limits = test.limits
+
+			start := time.Now()
+			_, err := limited.checkLimits(query.String(), nil)
+
+			assert.Less(t, time.Since(start), time.Second)
+
+			if test.expected == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.expected)
+			}
+		})
+	}
+}
+
+func TestController_CheckLimitsParseError(t *testing.T) {
+	t.Parallel()
+
+	controller := Controller{config: Config{Limits: LimitsConfig{MaxDepth: 3}}}
+
+	_, err := controller.checkLimits(`{ users(page: 1`, nil)
+
+	var syntaxErr *gqlerrors.Error
+	require.ErrorAs(t, err, &syntaxErr)
+	assert.Contains(t, syntaxErr.Message, "Syntax Error")
+}
+
+func TestController_MaxBodySize(t *testing.T) {
+	t.Parallel()
+
+	controller := Controller{config: Config{Limits: LimitsConfig{MaxBodySize: 64}}}
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/graphql",
+		strings.NewReader(`{"query":"{ users(page: 1, limit: 10) { users { id email country } } }"}`))
+	request.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+
+	_, ok := controller.readBody(recorder, request)
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), ErrRequestTooLarge.Error())
+}
+
+func TestController_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(ratelimit.Config{Key: ratelimit.KeyIP, Rate: 1, Burst: 1})
+	require.NoError(t, err)
+
+	controller := Controller{}
+	WithRateLimiter(limiter)(&controller)
+
+	allow := func() (*httptest.ResponseRecorder, bool) {
+		recorder := httptest.NewRecorder()
+
+		return recorder, controller.allow(recorder, httptest.NewRequest(http.MethodPost, "/api/v1/graphql", nil), 1)
+	}
+
+	allowed, ok := allow()
+	assert.True(t, ok)
+	assert.Equal(t, "0", allowed.Header().Get("RateLimit-Remaining"))
+
+	limited, ok := allow()
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.Equal(t, "1", limited.Header().Get("Retry-After"))
+	assert.Contains(t, limited.Body.String(), `"code":"RATE_LIMITED"`)
+}
+
+func TestController_FieldRoutes(t *testing.T) {
+	t.Parallel()
+
+	controller, err := New(Config{}, nil, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		query         string
+		operationName string
+		expected      []string
+	}{
+		{name: "query", query: `{ user(id: "1") { id } users { totalCount } }`, expected: []string{"Query.user", "Query.users"}},
+		{name: "aliases", query: `mutation { a: delete(id: "1") b: delete(id: "2") }`, expected: []string{"Mutation.delete", "Mutation.delete"}},
+		{
+			name:     "fragments",
+			query:    `mutation { ...Fields ...Fields ... on Mutation { create(input: {}) { id } } } fragment Fields on Mutation { delete(id: "1") }`,
+			expected: []string{"Mutation.delete", "Mutation.create"},
+		},
+		{
+			name:          "operation name",
+			query:         `query Read { users { totalCount } } mutation Write { delete(id: "1") }`,
+			operationName: "Write",
+			expected:      []string{"Mutation.delete"},
+		},
+		{name: "invalid", query: `mutation {`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, controller.fieldRoutes(test.query, test.operationName))
+		})
+	}
+}
+
+func TestController_FieldRateLimit(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(ratelimit.Config{
+		Key:    ratelimit.KeyIP,
+		Rate:   1,
+		Burst:  10,
+		Routes: []ratelimit.RouteConfig{{Route: "Mutation.delete", Rate: 0.001, Burst: 1}},
+	})
+	require.NoError(t, err)
+
+	controller, err := New(Config{}, nil, nil, WithRateLimiter(limiter))
+	require.NoError(t, err)
+
+	prepare := func(query string) (*httptest.ResponseRecorder, bool) {
+		body := `{"query":` + strconv.Quote(query) + `}`
+		request := httptest.NewRequest(http.MethodPost, DefaultPath, strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+
+		recorder := httptest.NewRecorder()
+
+		return recorder, controller.prepare(recorder, request, []byte(body))
+	}
+
+	_, ok := prepare(`mutation { delete(id: "1") }`)
+	assert.True(t, ok)
+
+	// Fields without a limit of their own are covered by the request token.
+	_, ok = prepare(`{ users { totalCount } }`)
+	assert.True(t, ok)
+
+	limited, ok := prepare(`mutation { delete(id: "2") }`)
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+	assert.NotEmpty(t, limited.Header().Get("Retry-After"))
+	assert.Contains(t, limited.Body.String(), `"code":"RATE_LIMITED"`)
+}
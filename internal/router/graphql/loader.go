@@ -0,0 +1,103 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+var ErrUserNotFound = errors.New("user not found")
+
+// userLoader coalesces the user lookups of one request. Resolvers return
+// thunks, which the executor calls once sibling fields are resolved, so the
+// first thunk called loads all users requested so far with a single call.
+// Results are cached for the request.
+type userLoader struct {
+	ctx     context.Context //nolint:containedctx
+	domain  domain.Contract
+	mutex   sync.Mutex
+	pending []uuid.UUID
+	results map[uuid.UUID]*userResult
+}
+
+type userResult struct {
+	user   *domain.User
+	err    error
+	loaded bool
+}
+
+type userLoaderKey struct{}
+
+// withUserLoader attaches a user loader to a request context.
+func withUserLoader(ctx context.Context, domain domain.Contract) context.Context {
+	return context.WithValue(ctx, userLoaderKey{}, &userLoader{
+		ctx:     ctx,
+		domain:  domain,
+		results: make(map[uuid.UUID]*userResult),
+	})
+}
+
+// loadUser returns a thunk resolving to the user, batched when the context carries a loader.
+func (c *Controller) loadUser(ctx context.Context, userID uuid.UUID) func() (*domain.User, error) {
+	loader, ok := ctx.Value(userLoaderKey{}).(*userLoader)
+	if !ok {
+		return func() (*domain.User, error) {
+			return c.domain.Get(ctx, userID)
+		}
+	}
+
+	return loader.load(userID)
+}
+
+func (l *userLoader) load(userID uuid.UUID) func() (*domain.User, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	result, ok := l.results[userID]
+	if !ok {
+		result = &userResult{}
+		l.results[userID] = result
+		l.pending = append(l.pending, userID)
+	}
+
+	return func() (*domain.User, error) {
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+
+		if !result.loaded {
+			l.dispatch()
+		}
+
+		return result.user, result.err
+	}
+}
+
+// dispatch loads the pending users, the caller holds the mutex.
+func (l *userLoader) dispatch() {
+	batch := l.pending
+	l.pending = nil
+
+	users, err := l.domain.GetMany(l.ctx, batch)
+
+	found := make(map[uuid.UUID]*domain.User, len(users))
+	for i := range users {
+		found[users[i].ID] = &users[i]
+	}
+
+	for _, userID := range batch {
+		result := l.results[userID]
+		result.loaded = true
+
+		switch user, ok := found[userID]; {
+		case err != nil:
+			result.err = err
+		case ok:
+			result.user = user
+		default:
+			result.err = ErrUserNotFound
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+func TestController_UserLoader(t *testing.T) {
+	t.Parallel()
+
+	john := domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+	jane := domain.User{ID: uuid.New(), Email: "jane.doe@example.com"}
+	unknownID := uuid.New()
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		GetMany(mock.Anything, mock.MatchedBy(func(userIDs []uuid.UUID) bool {
+			return assert.ElementsMatch(t, []uuid.UUID{john.ID, jane.ID, unknownID}, userIDs)
+		})).
+		Return([]domain.User{john, jane}, nil).
+		Once()
+
+	controller, err := New(Config{}, &mockDomain, nil)
+	require.NoError(t, err)
+
+	// Lookups, duplicates and node included, are coalesced into one call.
+	response := post(t, controller, `{"query":"{ `+
+		`a: user(id: \"`+john.ID.String()+`\") { email } `+
+		`b: user(id: \"`+jane.ID.String()+`\") { email } `+
+		`c: user(id: \"`+john.ID.String()+`\") { email } `+
+		`d: node(id: \"`+jane.ID.String()+`\") { ... on User { email } } `+
+		`e: user(id: \"`+unknownID.String()+`\") { email } }"}`)
+
+	var result struct {
+		Data   map[string]*struct{ Email string } `json:"data"`
+		Errors []struct{ Message string }         `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(response), &result))
+
+	assert.Equal(t, john.Email, result.Data["a"].Email)
+	assert.Equal(t, jane.Email, result.Data["b"].Email)
+	assert.Equal(t, john.Email, result.Data["c"].Email)
+	assert.Equal(t, jane.Email, result.Data["d"].Email)
+	assert.Nil(t, result.Data["e"])
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0].Message, ErrUserNotFound.Error())
+
+	mockDomain.AssertExpectations(t)
+}
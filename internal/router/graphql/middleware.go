@@ -7,6 +7,7 @@ import (
 	"github.com/graphql-go/graphql"
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
 	"github.com/yolkhovyy/go-otelw/otelw/tracew"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
 )
 
 func withTelemetry(next graphql.FieldResolveFn) graphql.FieldResolveFn {
@@ -24,6 +25,7 @@ func withTelemetry(next graphql.FieldResolveFn) graphql.FieldResolveFn {
 
 		logger.InfoContext(ctx, "graphql request begin",
 			slog.String("field name", params.Info.FieldName),
+			requestid.Attr(ctx),
 		)
 
 		params.Context = ctx
@@ -32,6 +34,7 @@ func withTelemetry(next graphql.FieldResolveFn) graphql.FieldResolveFn {
 		logger.InfoContext(ctx, "graphql request end",
 			slog.String("field name", params.Info.FieldName),
 			slog.Duration("duration(ms)", time.Since(start).Round(time.Millisecond)),
+			requestid.Attr(ctx),
 		)
 
 		return nxt, err
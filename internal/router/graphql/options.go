@@ -0,0 +1,13 @@
+package graphql
+
+import "github.com/yolkhovyy/go-userv/internal/health"
+
+// Option configures the GraphQL router.
+type Option func(*Controller)
+
+// WithHealth reports liveness and readiness from the registry, they are always up without one.
+func WithHealth(registry *health.Registry) Option {
+	return func(c *Controller) {
+		c.health = registry
+	}
+}
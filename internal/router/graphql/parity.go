@@ -0,0 +1,177 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+var ErrSchemaDrift = errors.New("schema drift")
+
+//nolint:gochecknoglobals
+var builtinScalars = []string{"String", "Int", "Float", "Boolean", "ID"}
+
+// checkParity fails when the executable schema and the SDL declare
+// different types, fields, arguments, enum values or nullability.
+// Federation types and fields, prefixed with _, are not part of the SDL.
+func checkParity(schema graphql.Schema, sdl string) error {
+	document, err := parser.Parse(parser.ParseParams{Source: sdl})
+	if err != nil {
+		return fmt.Errorf("sdl parse: %w", err)
+	}
+
+	expected := sdlSignatures(document)
+	actual := schemaSignatures(schema)
+
+	var drift []string
+
+	for _, signature := range expected {
+		if !slices.Contains(actual, signature) {
+			drift = append(drift, "missing in code: "+signature)
+		}
+	}
+
+	for _, signature := range actual {
+		if !slices.Contains(expected, signature) {
+			drift = append(drift, "missing in sdl: "+signature)
+		}
+	}
+
+	if len(drift) > 0 {
+		return fmt.Errorf("%w:\n%s", ErrSchemaDrift, strings.Join(drift, "\n"))
+	}
+
+	return nil
+}
+
+// schemaSignatures describes the user defined types of an executable schema, one line per element.
+func schemaSignatures(schema graphql.Schema) []string {
+	var signatures []string
+
+	for name, named := range schema.TypeMap() {
+		if strings.HasPrefix(name, "_") || slices.Contains(builtinScalars, name) {
+			continue
+		}
+
+		switch named := named.(type) {
+		case *graphql.Scalar:
+			signatures = append(signatures, "scalar "+name)
+		case *graphql.Enum:
+			for _, value := range named.Values() {
+				signatures = append(signatures, "enum "+name+"."+value.Name)
+			}
+		case *graphql.InputObject:
+			for fieldName, field := range named.Fields() {
+				signatures = append(signatures, "input "+name+"."+fieldName+": "+field.Type.String())
+			}
+		case *graphql.Interface:
+			signatures = append(signatures, fieldSignatures("interface "+name, named.Fields())...)
+		case *graphql.Object:
+			for _, iface := range named.Interfaces() {
+				signatures = append(signatures, "type "+name+" implements "+iface.Name())
+			}
+
+			signatures = append(signatures, fieldSignatures("type "+name, named.Fields())...)
+		case *graphql.Union:
+			for _, member := range named.Types() {
+				signatures = append(signatures, "union "+name+" = "+member.Name())
+			}
+		}
+	}
+
+	slices.Sort(signatures)
+
+	return signatures
+}
+
+func fieldSignatures(prefix string, fields graphql.FieldDefinitionMap) []string {
+	signatures := make([]string, 0, len(fields))
+
+	for name, field := range fields {
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+
+		signatures = append(signatures, prefix+"."+name+": "+field.Type.String())
+
+		for _, arg := range field.Args {
+			signatures = append(signatures, prefix+"."+name+"("+arg.Name()+"): "+arg.Type.String())
+		}
+	}
+
+	return signatures
+}
+
+// sdlSignatures describes the type definitions of an SDL document like schemaSignatures.
+//
+//nolint:cyclop
+func sdlSignatures(document *ast.Document) []string {
+	var signatures []string
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.ScalarDefinition:
+			signatures = append(signatures, "scalar "+definition.Name.Value)
+		case *ast.EnumDefinition:
+			for _, value := range definition.Values {
+				signatures = append(signatures, "enum "+definition.Name.Value+"."+value.Name.Value)
+			}
+		case *ast.InputObjectDefinition:
+			for _, field := range definition.Fields {
+				signatures = append(signatures,
+					"input "+definition.Name.Value+"."+field.Name.Value+": "+typeString(field.Type))
+			}
+		case *ast.InterfaceDefinition:
+			signatures = append(signatures, fieldDefinitionSignatures("interface "+definition.Name.Value,
+				definition.Fields)...)
+		case *ast.ObjectDefinition:
+			for _, iface := range definition.Interfaces {
+				signatures = append(signatures, "type "+definition.Name.Value+" implements "+iface.Name.Value)
+			}
+
+			signatures = append(signatures, fieldDefinitionSignatures("type "+definition.Name.Value,
+				definition.Fields)...)
+		case *ast.UnionDefinition:
+			for _, member := range definition.Types {
+				signatures = append(signatures, "union "+definition.Name.Value+" = "+member.Name.Value)
+			}
+		}
+	}
+
+	slices.Sort(signatures)
+
+	return signatures
+}
+
+func fieldDefinitionSignatures(prefix string, fields []*ast.FieldDefinition) []string {
+	signatures := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		signatures = append(signatures, prefix+"."+field.Name.Value+": "+typeString(field.Type))
+
+		for _, arg := range field.Arguments {
+			signatures = append(signatures,
+				prefix+"."+field.Name.Value+"("+arg.Name.Value+"): "+typeString(arg.Type))
+		}
+	}
+
+	return signatures
+}
+
+func typeString(astType ast.Type) string {
+	switch astType := astType.(type) {
+	case *ast.NonNull:
+		return typeString(astType.Type) + "!"
+	case *ast.List:
+		return "[" + typeString(astType.Type) + "]"
+	case *ast.Named:
+		return astType.Name.Value
+	}
+
+	return ""
+}
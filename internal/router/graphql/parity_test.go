@@ -0,0 +1,35 @@
+package graphql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gqlcontract "github.com/yolkhovyy/go-userv/contract/graphql"
+)
+
+func TestCheckParity(t *testing.T) {
+	t.Parallel()
+
+	var controller Controller
+
+	schema, err := graphql.NewSchema(controller.schemaConfig())
+	require.NoError(t, err)
+
+	require.NoError(t, checkParity(schema, gqlcontract.Schema))
+
+	drifted := strings.NewReplacer(
+		"delete(id: ID!): Boolean!", "delete(id: ID!): Boolean",
+		"  nickname: String!\n", "",
+		"  DELETED\n", "  DELETED\n  RESTORED\n",
+	).Replace(gqlcontract.Schema)
+
+	err = checkParity(schema, drifted)
+	require.ErrorIs(t, err, ErrSchemaDrift)
+	assert.Contains(t, err.Error(), "missing in code: type Mutation.delete: Boolean\n")
+	assert.Contains(t, err.Error(), "missing in sdl: type Mutation.delete: Boolean!\n")
+	assert.Contains(t, err.Error(), "missing in sdl: type User.nickname: String!")
+	assert.Contains(t, err.Error(), "missing in code: enum UserEventKind.RESTORED")
+}
@@ -0,0 +1,169 @@
+package graphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// Automatic persisted queries, see
+// https://www.apollographql.com/docs/apollo-server/performance/apq.
+
+var (
+	ErrPersistedQueryNotFound     = persistedQueryError{"PersistedQueryNotFound", "PERSISTED_QUERY_NOT_FOUND"}
+	ErrPersistedQueryNotSupported = persistedQueryError{"PersistedQueryNotSupported", "PERSISTED_QUERY_NOT_SUPPORTED"}
+	ErrPersistedQueryNotAllowed   = persistedQueryError{"PersistedQueryNotAllowed", "PERSISTED_QUERY_NOT_ALLOWED"}
+	ErrPersistedQueryHash         = persistedQueryError{"provided sha does not match query", "BAD_REQUEST"}
+	ErrPersistedQueryVersion      = persistedQueryError{"unsupported persisted query version", "BAD_REQUEST"}
+	ErrInvalidManifest            = errors.New("invalid persisted query manifest")
+)
+
+const (
+	persistedQueryVersion = 1
+	manifestFormat        = "apollo-persisted-query-manifest"
+)
+
+// persistedQueryError carries the code clients use to recognise APQ errors.
+type persistedQueryError struct {
+	message string
+	code    string
+}
+
+func (e persistedQueryError) Error() string {
+	return e.message
+}
+
+func (e persistedQueryError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+type extensions struct {
+	PersistedQuery *persistedQueryExtension `json:"persistedQuery,omitempty"`
+}
+
+type persistedQueryExtension struct {
+	Version    int    `json:"version"`
+	SHA256Hash string `json:"sha256Hash"`
+}
+
+// persistedQueries resolves query hashes, from the manifest in allowlist
+// mode, from queries registered by clients otherwise.
+type persistedQueries struct {
+	cache     *lru.Cache[string, string]
+	allowlist map[string]string
+}
+
+// manifest is an Apollo persisted query manifest, see
+// https://www.apollographql.com/docs/graphos/routing/security/persisted-queries.
+type manifest struct {
+	Format     string `json:"format"`
+	Version    int    `json:"version"`
+	Operations []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+func newPersistedQueries(config PersistedQueriesConfig) (*persistedQueries, error) {
+	if config.Allowlist {
+		allowlist, err := loadManifest(config.Manifest)
+		if err != nil {
+			return nil, fmt.Errorf("persisted queries: %w", err)
+		}
+
+		return &persistedQueries{allowlist: allowlist}, nil
+	}
+
+	cache, err := lru.New[string, string](config.CacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("persisted queries cache: %w", err)
+	}
+
+	return &persistedQueries{cache: cache}, nil
+}
+
+func loadManifest(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("manifest read: %w", err)
+	}
+
+	var manifest manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("manifest unmarshal: %w", err)
+	}
+
+	if manifest.Format != manifestFormat || manifest.Version != persistedQueryVersion {
+		return nil, fmt.Errorf("manifest %s version %d: %w", manifest.Format, manifest.Version, ErrInvalidManifest)
+	}
+
+	allowlist := make(map[string]string, len(manifest.Operations))
+
+	for _, operation := range manifest.Operations {
+		if queryHash(operation.Body) != operation.ID {
+			return nil, fmt.Errorf("manifest operation %s: %w", operation.Name, ErrPersistedQueryHash)
+		}
+
+		allowlist[operation.ID] = operation.Body
+	}
+
+	return allowlist, nil
+}
+
+// resolve returns the query text of a request, looking it up by hash
+// when only the hash is sent and registering it when both are sent.
+func (p *persistedQueries) resolve(query string, extension *persistedQueryExtension) (string, error) {
+	if extension == nil {
+		if p.allowlist == nil || query == "" {
+			return query, nil
+		}
+
+		if _, ok := p.allowlist[queryHash(query)]; !ok {
+			return "", ErrPersistedQueryNotAllowed
+		}
+
+		return query, nil
+	}
+
+	if extension.Version != persistedQueryVersion {
+		return "", ErrPersistedQueryVersion
+	}
+
+	if query != "" && queryHash(query) != extension.SHA256Hash {
+		return "", ErrPersistedQueryHash
+	}
+
+	if p.allowlist != nil {
+		persisted, ok := p.allowlist[extension.SHA256Hash]
+		if !ok {
+			return "", ErrPersistedQueryNotAllowed
+		}
+
+		return persisted, nil
+	}
+
+	if query != "" {
+		p.cache.Add(extension.SHA256Hash, query)
+
+		return query, nil
+	}
+
+	persisted, ok := p.cache.Get(extension.SHA256Hash)
+	if !ok {
+		return "", ErrPersistedQueryNotFound
+	}
+
+	return persisted, nil
+}
+
+func queryHash(query string) string {
+	hash := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,108 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const persistedQuery = `{ __typename }`
+
+func TestController_AutomaticPersistedQueries(t *testing.T) {
+	t.Parallel()
+
+	controller, err := New(Config{PersistedQueries: PersistedQueriesConfig{
+		Enable:    true,
+		CacheSize: DefaultPersistedQueriesCacheSize,
+	}}, nil, nil)
+	require.NoError(t, err)
+
+	extensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + queryHash(persistedQuery) + `"}}`
+
+	// The hash alone is unknown until registered.
+	response := get(t, controller, url.Values{"extensions": {extensions}})
+	assert.JSONEq(t,
+		`{"errors":[{"message":"PersistedQueryNotFound","locations":[],"extensions":{"code":"PERSISTED_QUERY_NOT_FOUND"}}]}`,
+		response)
+
+	// A mismatching hash is refused.
+	response = post(t, controller, `{"query":"{ users { totalCount } }","extensions":`+extensions+`}`)
+	assert.Contains(t, response, ErrPersistedQueryHash.Error())
+
+	// The query registers the hash.
+	response = post(t, controller, `{"query":"`+persistedQuery+`","extensions":`+extensions+`}`)
+	assert.JSONEq(t, `{"data":{"__typename":"Query"}}`, response)
+
+	response = get(t, controller, url.Values{"extensions": {extensions}})
+	assert.JSONEq(t, `{"data":{"__typename":"Query"}}`, response)
+}
+
+func TestController_PersistedQueriesAllowlist(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := json.Marshal(map[string]any{
+		"format":  manifestFormat,
+		"version": 1,
+		"operations": []map[string]any{
+			{"id": queryHash(persistedQuery), "name": "typename", "type": "query", "body": persistedQuery},
+		},
+	})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "manifest.json")
+	require.NoError(t, os.WriteFile(path, manifest, 0o600))
+
+	controller, err := New(Config{PersistedQueries: PersistedQueriesConfig{
+		Enable:    true,
+		Allowlist: true,
+		Manifest:  path,
+	}}, nil, nil)
+	require.NoError(t, err)
+
+	extensions := `{"persistedQuery":{"version":1,"sha256Hash":"` + queryHash(persistedQuery) + `"}}`
+
+	response := get(t, controller, url.Values{"extensions": {extensions}})
+	assert.JSONEq(t, `{"data":{"__typename":"Query"}}`, response)
+
+	response = post(t, controller, `{"query":"`+persistedQuery+`"}`)
+	assert.JSONEq(t, `{"data":{"__typename":"Query"}}`, response)
+
+	// Ad-hoc queries are refused.
+	response = post(t, controller, `{"query":"{ __schema { queryType { name } } }"}`)
+	assert.Contains(t, response, "PERSISTED_QUERY_NOT_ALLOWED")
+}
+
+func get(t *testing.T, handler http.Handler, values url.Values) string {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/graphql?"+values.Encode(), nil)
+
+	return serve(t, handler, request)
+}
+
+func post(t *testing.T, handler http.Handler, body string) string {
+	t.Helper()
+
+	request := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+
+	return serve(t, handler, request)
+}
+
+func serve(t *testing.T, handler http.Handler, request *http.Request) string {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	return recorder.Body.String()
+}
@@ -0,0 +1,202 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+// WithRateLimiter limits the requests of clients to the GraphQL endpoint.
+func WithRateLimiter(limiter *ratelimit.Limiter) Option {
+	return func(c *Controller) {
+		c.limiter = limiter
+	}
+}
+
+// rateLimitError carries the code clients use to recognise rate limited requests.
+type rateLimitError struct{}
+
+func (rateLimitError) Error() string {
+	return ratelimit.ErrLimited.Error()
+}
+
+func (rateLimitError) Extensions() map[string]any {
+	return map[string]any{"code": "RATE_LIMITED"}
+}
+
+func (rateLimitError) Unwrap() error {
+	return ratelimit.ErrLimited
+}
+
+// allow takes tokens for the request, one per operation, limited requests get
+// a 429 response with a Retry-After header. Requests are let through when the store fails.
+func (c *Controller) allow(writer http.ResponseWriter, request *http.Request, tokens int) bool {
+	result, err := c.take(request.Context(), request.Method+" "+request.URL.Path, c.client(request), tokens)
+	if err != nil {
+		return true
+	}
+
+	ratelimit.SetHeaders(writer.Header(), result)
+
+	if !result.Allowed {
+		writeErrors(writer, http.StatusTooManyRequests, rateLimitError{})
+
+		return false
+	}
+
+	return true
+}
+
+// allowFields takes a token per call of the root fields of queries with a limit of
+// their own, limited requests get a 429 response with a Retry-After header.
+func (c *Controller) allowFields(writer http.ResponseWriter, request *http.Request, queries ...operationPayload) bool {
+	var routes []string
+	for _, query := range queries {
+		routes = append(routes, c.fieldRoutes(query.Query, query.OperationName)...)
+	}
+
+	result, ok := c.takeFields(request.Context(), c.client(request), routes)
+	if !ok {
+		ratelimit.SetHeaders(writer.Header(), result)
+		writeErrors(writer, http.StatusTooManyRequests, rateLimitError{})
+	}
+
+	return ok
+}
+
+// takeFields takes a token of client per route with a limit of its own, other
+// routes are covered by the request token. It returns false with the denied result
+// when a route is limited, store failures let the fields through.
+func (c *Controller) takeFields(ctx context.Context, client ratelimit.Client, routes []string) (ratelimit.Result, bool) {
+	for _, route := range routes {
+		if !c.limiter.HasRoute(route) {
+			continue
+		}
+
+		result, err := c.take(ctx, route, client, 1)
+		if err == nil && !result.Allowed {
+			return result, false
+		}
+	}
+
+	return ratelimit.Result{}, true
+}
+
+// fieldRoutes returns the routes of the root field calls of an operation, e.g. Mutation.create,
+// fields of fragments at the root included.
+func (c *Controller) fieldRoutes(query string, operationName string) []string {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil
+	}
+
+	var operation *ast.OperationDefinition
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operation == nil && (operationName == "" ||
+				(definition.Name != nil && definition.Name.Value == operationName)) {
+				operation = definition
+			}
+		}
+	}
+
+	if operation == nil {
+		return nil
+	}
+
+	var root *graphql.Object
+
+	switch operation.Operation {
+	case ast.OperationTypeQuery:
+		root = c.schema.QueryType()
+	case ast.OperationTypeMutation:
+		root = c.schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = c.schema.SubscriptionType()
+	}
+
+	if root == nil {
+		return nil
+	}
+
+	var (
+		routes []string
+		walk   func(selectionSet *ast.SelectionSet)
+	)
+
+	spread := make(map[string]bool)
+
+	walk = func(selectionSet *ast.SelectionSet) {
+		if selectionSet == nil {
+			return
+		}
+
+		for _, selection := range selectionSet.Selections {
+			switch selection := selection.(type) {
+			case *ast.Field:
+				routes = append(routes, root.Name()+"."+selection.Name.Value)
+			case *ast.InlineFragment:
+				walk(selection.SelectionSet)
+			case *ast.FragmentSpread:
+				if name := selection.Name.Value; !spread[name] {
+					spread[name] = true
+
+					if fragment, ok := fragments[name]; ok {
+						walk(fragment.SelectionSet)
+					}
+				}
+			}
+		}
+	}
+
+	walk(operation.SelectionSet)
+
+	return routes
+}
+
+// client identifies the client of a request, clients behind trusted proxies
+// are identified by X-Forwarded-For.
+func (c *Controller) client(request *http.Request) ratelimit.Client {
+	return ratelimit.Client{
+		IP:     c.proxies.ClientIP(request),
+		APIKey: request.Header.Get(c.limiter.APIKeyHeader()),
+	}
+}
+
+// take takes tokens of client for route, up to the first denied one.
+// Store failures are logged.
+func (c *Controller) take(ctx context.Context, route string, client ratelimit.Client, tokens int) (ratelimit.Result, error) {
+	var result ratelimit.Result
+
+	for range tokens {
+		var err error
+
+		result, err = c.limiter.Allow(ctx, route, client)
+		if err != nil {
+			slogw.DefaultLogger().ErrorContext(ctx, "rate limit",
+				slog.String("allow", err.Error()),
+			)
+
+			return result, fmt.Errorf("rate limit: %w", err)
+		}
+
+		if !result.Allowed {
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,203 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+// Relay global object identification and cursor connections,
+// see https://relay.dev/graphql/objectidentification.htm
+// and https://relay.dev/graphql/connections.htm.
+// User is the only node type, its UUID is used as the global id.
+
+var (
+	ErrInvalidNodeID   = errors.New("invalid node id")
+	ErrInvalidCursor   = errors.New("invalid cursor")
+	ErrInvalidPageSize = errors.New("invalid page size")
+	ErrFirstAndLast    = errors.New("first and last are mutually exclusive")
+)
+
+const (
+	userNodeType          = "User"
+	defaultConnectionSize = 10
+	maxConnectionSize     = 1000
+)
+
+// toCursor encodes the position of a user in the connection order.
+func toCursor(user domain.User) string {
+	position := strconv.FormatInt(user.CreatedAt.UnixMicro(), 10) + ":" + user.ID.String()
+
+	return base64.StdEncoding.EncodeToString([]byte(position))
+}
+
+func fromCursor(cursor string) (*domain.Cursor, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("cursor decode: %w", ErrInvalidCursor)
+	}
+
+	micros, id, found := strings.Cut(string(data), ":")
+	if !found {
+		return nil, fmt.Errorf("cursor split: %w", ErrInvalidCursor)
+	}
+
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("cursor time: %w", ErrInvalidCursor)
+	}
+
+	userID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("cursor uuid: %w", ErrInvalidCursor)
+	}
+
+	return &domain.Cursor{CreatedAt: time.UnixMicro(createdAt), ID: userID}, nil
+}
+
+// userSource returns the user resolved by a parent field.
+func userSource(source any) (*domain.User, bool) {
+	switch user := source.(type) {
+	case *domain.User:
+		return user, user != nil
+	case domain.User:
+		return &user, true
+	}
+
+	return nil, false
+}
+
+type userConnection struct {
+	Edges      []userEdge `json:"edges"`
+	PageInfo   pageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+type userEdge struct {
+	Node   domain.User `json:"node"`
+	Cursor string      `json:"cursor"`
+}
+
+type pageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+func connectionFromPage(page *domain.UserPage) userConnection {
+	connection := userConnection{
+		Edges: make([]userEdge, len(page.Users)),
+		PageInfo: pageInfo{
+			HasNextPage:     page.HasNext,
+			HasPreviousPage: page.HasPrevious,
+		},
+		TotalCount: page.TotalCount,
+	}
+
+	for i, user := range page.Users {
+		connection.Edges[i] = userEdge{Node: user, Cursor: toCursor(user)}
+	}
+
+	if len(connection.Edges) > 0 {
+		connection.PageInfo.StartCursor = &connection.Edges[0].Cursor
+		connection.PageInfo.EndCursor = &connection.Edges[len(connection.Edges)-1].Cursor
+	}
+
+	return connection
+}
+
+// pageQuery builds a page query from the connection arguments,
+// first pages forward and last backward, only one of them may be given.
+func pageQuery(args inputMap) (*domain.PageQuery, error) {
+	var query domain.PageQuery
+
+	first, hasFirst := args.intValue("first")
+	last, hasLast := args.intValue("last")
+
+	switch {
+	case hasFirst && hasLast:
+		return nil, ErrFirstAndLast
+	case hasFirst && (first < 1 || first > maxConnectionSize):
+		return nil, fmt.Errorf("first %d: %w", first, ErrInvalidPageSize)
+	case hasLast && (last < 1 || last > maxConnectionSize):
+		return nil, fmt.Errorf("last %d: %w", last, ErrInvalidPageSize)
+	case hasFirst:
+		query.First = first
+	case hasLast:
+		query.Last = last
+	default:
+		query.First = defaultConnectionSize
+	}
+
+	var err error
+
+	if query.After, err = args.cursorValue("after"); err != nil {
+		return nil, fmt.Errorf("after: %w", err)
+	}
+
+	if query.Before, err = args.cursorValue("before"); err != nil {
+		return nil, fmt.Errorf("before: %w", err)
+	}
+
+	if filter, ok := args["filter"].(map[string]any); ok {
+		query.Country, _ = inputMap(filter).stringValue("country")
+	}
+
+	return &query, nil
+}
+
+// cursorValue returns the decoded cursor argument, nil if absent.
+func (im inputMap) cursorValue(key string) (*domain.Cursor, error) {
+	value, ok := im.stringValue(key)
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return fromCursor(value)
+}
+
+func (c *Controller) node() graphql.FieldResolveFn {
+	return withTelemetry(func(params graphql.ResolveParams) (any, error) {
+		var input inputMap = params.Args
+
+		id, err := input.uuidValue("id")
+		if err != nil {
+			return nil, fmt.Errorf("node resolver: %w", ErrInvalidNodeID)
+		}
+
+		load := c.loadUser(params.Context, id)
+
+		return func() (any, error) {
+			user, err := load()
+			if err != nil {
+				return nil, fmt.Errorf("node resolver: %w", err)
+			}
+
+			return user, nil
+		}, nil
+	})
+}
+
+func (c *Controller) usersConnection() graphql.FieldResolveFn {
+	return withTelemetry(func(params graphql.ResolveParams) (any, error) {
+		query, err := pageQuery(params.Args)
+		if err != nil {
+			return nil, fmt.Errorf("users connection resolver: %w", err)
+		}
+
+		page, err := c.domain.Page(params.Context, *query)
+		if err != nil {
+			return nil, fmt.Errorf("users connection resolver: %w", err)
+		}
+
+		return connectionFromPage(page), nil
+	})
+}
@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+func TestPageQuery(t *testing.T) {
+	t.Parallel()
+
+	user := domain.User{ID: uuid.New(), CreatedAt: time.UnixMicro(time.Now().UnixMicro())}
+	cursor := toCursor(user)
+
+	tests := []struct {
+		name  string
+		args  inputMap
+		query *domain.PageQuery
+		err   error
+	}{
+		{
+			name:  "default",
+			args:  inputMap{},
+			query: &domain.PageQuery{First: defaultConnectionSize},
+		},
+		{
+			name:  "forward",
+			args:  inputMap{"first": 5, "after": cursor, "filter": map[string]any{"country": "GB"}},
+			query: &domain.PageQuery{First: 5, After: &domain.Cursor{CreatedAt: user.CreatedAt, ID: user.ID}, Country: "GB"},
+		},
+		{
+			name:  "backward",
+			args:  inputMap{"last": 5, "before": cursor},
+			query: &domain.PageQuery{Last: 5, Before: &domain.Cursor{CreatedAt: user.CreatedAt, ID: user.ID}},
+		},
+		{name: "negative", args: inputMap{"first": -1}, err: ErrInvalidPageSize},
+		{name: "zero first", args: inputMap{"first": 0}, err: ErrInvalidPageSize},
+		{name: "zero last", args: inputMap{"last": 0}, err: ErrInvalidPageSize},
+		{name: "first and last", args: inputMap{"first": 5, "last": 5}, err: ErrFirstAndLast},
+		{name: "too large", args: inputMap{"last": maxConnectionSize + 1}, err: ErrInvalidPageSize},
+		{name: "bad cursor", args: inputMap{"after": "bad"}, err: ErrInvalidCursor},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := pageQuery(test.args)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.query.First, query.First)
+			assert.Equal(t, test.query.Last, query.Last)
+			assert.Equal(t, test.query.Country, query.Country)
+			assertCursor(t, test.query.After, query.After)
+			assertCursor(t, test.query.Before, query.Before)
+		})
+	}
+}
+
+func TestConnectionFromPage(t *testing.T) {
+	t.Parallel()
+
+	users := []domain.User{{ID: uuid.New()}, {ID: uuid.New()}}
+
+	connection := connectionFromPage(&domain.UserPage{Users: users, HasNext: true, TotalCount: 3})
+
+	require.Len(t, connection.Edges, 2)
+	assert.Equal(t, users[0], connection.Edges[0].Node)
+	assert.Equal(t, toCursor(users[0]), *connection.PageInfo.StartCursor)
+	assert.Equal(t, toCursor(users[1]), *connection.PageInfo.EndCursor)
+	assert.True(t, connection.PageInfo.HasNextPage)
+	assert.False(t, connection.PageInfo.HasPreviousPage)
+	assert.Equal(t, 3, connection.TotalCount)
+
+	empty := connectionFromPage(&domain.UserPage{})
+	assert.Empty(t, empty.Edges)
+	assert.Nil(t, empty.PageInfo.StartCursor)
+	assert.Nil(t, empty.PageInfo.EndCursor)
+}
+
+func assertCursor(t *testing.T, expected, actual *domain.Cursor) {
+	t.Helper()
+
+	if expected == nil {
+		assert.Nil(t, actual)
+
+		return
+	}
+
+	require.NotNil(t, actual)
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt))
+	assert.Equal(t, expected.ID, actual.ID)
+}
@@ -0,0 +1,176 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/handler"
+)
+
+var (
+	ErrMutationOverGet      = errors.New("mutations are not allowed over GET")
+	ErrMultipartUnsupported = errors.New("multipart requests are not supported, the schema has no file uploads")
+)
+
+// operationPayload is a GraphQL operation sent in a batch or over WebSocket.
+type operationPayload struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+	Extensions    extensions     `json:"extensions"`
+}
+
+// isMultipart tells whether a request is a multipart request, e.g. a file upload of the
+// GraphQL multipart request spec, see https://github.com/jaydenseric/graphql-multipart-request-spec.
+func isMultipart(request *http.Request) bool {
+	return strings.HasPrefix(request.Header.Get("Content-Type"), "multipart/")
+}
+
+// readBody reads the request body within the size limit,
+// it writes an error response and returns false on failure.
+func (c *Controller) readBody(writer http.ResponseWriter, request *http.Request) ([]byte, bool) {
+	if c.config.Limits.MaxBodySize > 0 {
+		request.Body = http.MaxBytesReader(writer, request.Body, c.config.Limits.MaxBodySize)
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeErrors(writer, http.StatusRequestEntityTooLarge,
+				fmt.Errorf("%w: body exceeds %d bytes", ErrRequestTooLarge, maxBytesErr.Limit))
+		} else {
+			writeErrors(writer, http.StatusBadRequest, fmt.Errorf("request body read: %w", err))
+		}
+
+		return nil, false
+	}
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, true
+}
+
+// prepare resolves persisted queries and checks the query limits of a single
+// operation request. It writes an error response and returns false when the
+// request must not be executed.
+func (c *Controller) prepare(writer http.ResponseWriter, request *http.Request, body []byte) bool {
+	options := handler.NewRequestOptions(request)
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	query, err := c.resolveQuery(options.Query, requestExtensions(request, body))
+	if err != nil {
+		writeErrors(writer, http.StatusOK, err)
+
+		return false
+	}
+
+	// Queries sent with GET may be cached, mutations are not.
+	if request.Method == http.MethodGet && operationType(query, options.OperationName) == ast.OperationTypeMutation {
+		writer.Header().Set("Allow", http.MethodPost)
+		writeErrors(writer, http.StatusMethodNotAllowed, ErrMutationOverGet)
+
+		return false
+	}
+
+	if _, err := c.checkLimits(query, options.Variables); err != nil {
+		writeErrors(writer, http.StatusOK, err)
+
+		return false
+	}
+
+	if c.limiter != nil &&
+		!c.allowFields(writer, request, operationPayload{Query: query, OperationName: options.OperationName}) {
+		return false
+	}
+
+	if query != options.Query {
+		options.Query = query
+		if err := setRequestOptions(request, options); err != nil {
+			writeErrors(writer, http.StatusBadRequest, err)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// preparePayload resolves persisted queries and checks the query limits of an operation,
+// it returns the cost of the operation.
+func (c *Controller) preparePayload(payload *operationPayload) (queryCost, error) {
+	query, err := c.resolveQuery(payload.Query, payload.Extensions)
+	if err != nil {
+		return queryCost{}, err
+	}
+
+	payload.Query = query
+
+	return c.checkLimits(payload.Query, payload.Variables)
+}
+
+// resolveQuery returns the query text, from the persisted queries
+// when the request carries a persisted query hash.
+func (c *Controller) resolveQuery(query string, extensions extensions) (string, error) {
+	if c.persisted == nil {
+		if query == "" && extensions.PersistedQuery != nil {
+			return "", ErrPersistedQueryNotSupported
+		}
+
+		return query, nil
+	}
+
+	return c.persisted.resolve(query, extensions.PersistedQuery)
+}
+
+// requestExtensions returns the extensions of GET or JSON POST requests.
+func requestExtensions(request *http.Request, body []byte) extensions {
+	var payload struct {
+		Extensions extensions `json:"extensions"`
+	}
+
+	if value := request.URL.Query().Get("extensions"); value != "" {
+		_ = json.Unmarshal([]byte(value), &payload.Extensions)
+
+		return payload.Extensions
+	}
+
+	contentType, _, _ := strings.Cut(request.Header.Get("Content-Type"), ";")
+	if request.Method != http.MethodPost ||
+		contentType == handler.ContentTypeGraphQL || contentType == handler.ContentTypeFormURLEncoded {
+		return payload.Extensions
+	}
+
+	_ = json.Unmarshal(body, &payload)
+
+	return payload.Extensions
+}
+
+// setRequestOptions passes the options to the handler in the URL query, read before the body.
+func setRequestOptions(request *http.Request, options *handler.RequestOptions) error {
+	values := url.Values{"query": {options.Query}}
+
+	if options.OperationName != "" {
+		values.Set("operationName", options.OperationName)
+	}
+
+	if options.Variables != nil {
+		variables, err := json.Marshal(options.Variables)
+		if err != nil {
+			return fmt.Errorf("variables marshal: %w", err)
+		}
+
+		values.Set("variables", string(variables))
+	}
+
+	request.URL.RawQuery = values.Encode()
+
+	return nil
+}
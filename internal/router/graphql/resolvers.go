@@ -14,6 +14,9 @@ var (
 	ErrMissingKey    = errors.New("missing key")
 )
 
+// defaultUsersLimit is the page size of users called without a limit.
+const defaultUsersLimit = 10
+
 func (c *Controller) user() graphql.FieldResolveFn {
 	return withTelemetry(func(params graphql.ResolveParams) (any, error) {
 		var input inputMap = params.Args
@@ -23,21 +26,22 @@ func (c *Controller) user() graphql.FieldResolveFn {
 			return nil, fmt.Errorf("user resolver: %w", ErrMissingKey)
 		}
 
-		user, err := c.domain.Get(params.Context, userID)
-		if err != nil {
-			return nil, fmt.Errorf("user resolver: %w", err)
-		}
+		load := c.loadUser(params.Context, userID)
+
+		return func() (any, error) {
+			user, err := load()
+			if err != nil {
+				return nil, fmt.Errorf("user resolver: %w", err)
+			}
 
-		return user, nil
+			return user, nil
+		}, nil
 	})
 }
 
 func (c *Controller) users() graphql.FieldResolveFn {
 	return withTelemetry(func(params graphql.ResolveParams) (any, error) {
-		const (
-			defaultPage  = 1
-			defaultLimit = 10
-		)
+		const defaultPage = 1
 
 		var input inputMap = params.Args
 
@@ -46,7 +50,7 @@ func (c *Controller) users() graphql.FieldResolveFn {
 			page = value
 		}
 
-		limit := defaultLimit
+		limit := defaultUsersLimit
 		if value, ok := input.intValue("limit"); ok {
 			limit = value
 		}
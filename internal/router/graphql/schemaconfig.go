@@ -18,7 +18,7 @@ func (c *Controller) schemaConfig() graphql.SchemaConfig {
 				Resolve: c.user(),
 			},
 			"users": &graphql.Field{
-				Type: usersType,
+				Type: graphql.NewNonNull(usersType),
 				Args: graphql.FieldConfigArgument{
 					"page":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
 					"limit":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
@@ -26,14 +26,38 @@ func (c *Controller) schemaConfig() graphql.SchemaConfig {
 				},
 				Resolve: c.users(),
 			},
+			"node": &graphql.Field{
+				Type: nodeInterface,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.ID),
+					},
+				},
+				Resolve: c.node(),
+			},
+			"usersConnection": &graphql.Field{
+				Type: graphql.NewNonNull(userConnectionType),
+				Args: graphql.FieldConfigArgument{
+					"first":  &graphql.ArgumentConfig{Type: graphql.Int},
+					"after":  &graphql.ArgumentConfig{Type: graphql.String},
+					"last":   &graphql.ArgumentConfig{Type: graphql.Int},
+					"before": &graphql.ArgumentConfig{Type: graphql.String},
+					"filter": &graphql.ArgumentConfig{Type: userFilterType},
+				},
+				Resolve: c.usersConnection(),
+			},
 		},
 	})
 
+	for name, field := range c.federationFields() {
+		queryType.AddFieldConfig(name, field)
+	}
+
 	mutationType := graphql.NewObject(graphql.ObjectConfig{
 		Name: "Mutation",
 		Fields: graphql.Fields{
 			"create": &graphql.Field{
-				Type: userType,
+				Type: graphql.NewNonNull(userType),
 				Args: graphql.FieldConfigArgument{
 					"input": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(userCreateType),
@@ -42,7 +66,7 @@ func (c *Controller) schemaConfig() graphql.SchemaConfig {
 				Resolve: c.create(),
 			},
 			"update": &graphql.Field{
-				Type: userType,
+				Type: graphql.NewNonNull(userType),
 				Args: graphql.FieldConfigArgument{
 					"input": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(userUpdateType),
@@ -51,7 +75,7 @@ func (c *Controller) schemaConfig() graphql.SchemaConfig {
 				Resolve: c.update(),
 			},
 			"delete": &graphql.Field{
-				Type: graphql.Boolean,
+				Type: graphql.NewNonNull(graphql.Boolean),
 				Args: graphql.FieldConfigArgument{
 					"id": &graphql.ArgumentConfig{
 						Type: graphql.NewNonNull(graphql.ID),
@@ -62,8 +86,26 @@ func (c *Controller) schemaConfig() graphql.SchemaConfig {
 		},
 	})
 
+	subscriptionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"userChanged": &graphql.Field{
+				Type: graphql.NewNonNull(userEventType),
+				Args: graphql.FieldConfigArgument{
+					"id":      &graphql.ArgumentConfig{Type: graphql.ID},
+					"country": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Subscribe: c.userChanged(),
+				Resolve: withTelemetry(func(params graphql.ResolveParams) (any, error) {
+					return params.Source, nil
+				}),
+			},
+		},
+	})
+
 	return graphql.SchemaConfig{
-		Query:    queryType,
-		Mutation: mutationType,
+		Query:        queryType,
+		Mutation:     mutationType,
+		Subscription: subscriptionType,
 	}
 }
@@ -0,0 +1,449 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+)
+
+// Server side of the graphql-transport-ws protocol,
+// see https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md.
+const (
+	wsProtocol = "graphql-transport-ws"
+
+	wsConnectionInit = "connection_init"
+	wsConnectionAck  = "connection_ack"
+	wsPing           = "ping"
+	wsPong           = "pong"
+	wsSubscribe      = "subscribe"
+	wsNext           = "next"
+	wsError          = "error"
+	wsComplete       = "complete"
+
+	wsCloseInvalidMessage    = 4400
+	wsCloseUnauthorized      = 4401
+	wsCloseBadProtocol       = 4406
+	wsCloseInitTimeout       = 4408
+	wsCloseSubscriberExists  = 4409
+	wsCloseTooManyInitialize = 4429
+
+	wsReadLimit    = 1 << 20
+	wsWriteTimeout = 10 * time.Second
+)
+
+var (
+	ErrSubscriptionsDisabled = errors.New("subscriptions disabled")
+	ErrTooManySubscriptions  = errors.New("connection has too many operations")
+)
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type wsConnection struct {
+	conn    *websocket.Conn
+	schema  graphql.Schema
+	domain  domain.Contract
+	config  SubscriptionsConfig
+	prepare func(payload *operationPayload) (queryCost, error)
+	// allow takes a rate limit token for an operation, nil without a limiter.
+	allow func(ctx context.Context) bool
+	// allowFields takes the rate limit tokens of the root fields of an operation, nil without a limiter.
+	allowFields func(ctx context.Context, payload operationPayload) bool
+	writeMutex  sync.Mutex
+	mutex       sync.Mutex
+	ctx         context.Context //nolint:containedctx
+	cancel      context.CancelFunc
+	acked       bool
+	operations  map[string]context.CancelFunc
+}
+
+func (c *Controller) serveSubscriptions(writer http.ResponseWriter, request *http.Request) {
+	logger := slogw.DefaultLogger()
+
+	upgrader := websocket.Upgrader{Subprotocols: []string{wsProtocol}}
+
+	conn, err := upgrader.Upgrade(writer, request, nil)
+	if err != nil {
+		logger.ErrorContext(request.Context(), "graphql subscriptions",
+			slog.String("upgrade", err.Error()))
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.WithoutCancel(request.Context()))
+
+	connection := &wsConnection{
+		conn:       conn,
+		schema:     c.schema,
+		domain:     c.domain,
+		config:     c.config.Subscriptions,
+		prepare:    c.preparePayload,
+		ctx:        ctx,
+		cancel:     cancel,
+		operations: make(map[string]context.CancelFunc),
+	}
+
+	// Each operation takes a token of the client upgrading the connection.
+	if c.limiter != nil {
+		route, client := request.Method+" "+request.URL.Path, c.client(request)
+
+		connection.allow = func(ctx context.Context) bool {
+			result, err := c.take(ctx, route, client, 1)
+
+			return err != nil || result.Allowed
+		}
+
+		connection.allowFields = func(ctx context.Context, payload operationPayload) bool {
+			_, ok := c.takeFields(ctx, client, c.fieldRoutes(payload.Query, payload.OperationName))
+
+			return ok
+		}
+	}
+
+	connection.serve()
+}
+
+func (c *wsConnection) serve() {
+	defer c.cancel()
+	defer c.conn.Close()
+
+	if c.conn.Subprotocol() != wsProtocol {
+		c.close(wsCloseBadProtocol, "Subprotocol not acceptable")
+
+		return
+	}
+
+	c.conn.SetReadLimit(wsReadLimit)
+
+	if c.config.InitTimeout > 0 {
+		initTimer := time.AfterFunc(c.config.InitTimeout, func() {
+			c.mutex.Lock()
+			acked := c.acked
+			c.mutex.Unlock()
+
+			if !acked {
+				c.close(wsCloseInitTimeout, "Connection initialisation timeout")
+			}
+		})
+		defer initTimer.Stop()
+	}
+
+	if c.config.PingInterval > 0 {
+		go c.ping()
+	}
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var message wsMessage
+		if err := json.Unmarshal(data, &message); err != nil {
+			c.close(wsCloseInvalidMessage, "Invalid message received")
+
+			return
+		}
+
+		if !c.handle(message) {
+			return
+		}
+	}
+}
+
+// handle processes a client message, it returns false when the connection was closed.
+func (c *wsConnection) handle(message wsMessage) bool {
+	switch message.Type {
+	case wsConnectionInit:
+		c.mutex.Lock()
+		acked := c.acked
+		c.acked = true
+		c.mutex.Unlock()
+
+		if acked {
+			c.close(wsCloseTooManyInitialize, "Too many initialisation requests")
+
+			return false
+		}
+
+		c.write(wsMessage{Type: wsConnectionAck})
+
+	case wsPing:
+		c.write(wsMessage{Type: wsPong})
+
+	case wsPong:
+
+	case wsSubscribe:
+		return c.subscribe(message)
+
+	case wsComplete:
+		c.mutex.Lock()
+		if cancel, ok := c.operations[message.ID]; ok {
+			delete(c.operations, message.ID)
+			cancel()
+		}
+		c.mutex.Unlock()
+
+	default:
+		c.close(wsCloseInvalidMessage, "Invalid message received")
+
+		return false
+	}
+
+	return true
+}
+
+func (c *wsConnection) subscribe(message wsMessage) bool {
+	var payload operationPayload
+	if message.ID == "" || json.Unmarshal(message.Payload, &payload) != nil {
+		c.close(wsCloseInvalidMessage, "Invalid message received")
+
+		return false
+	}
+
+	c.mutex.Lock()
+
+	if !c.acked {
+		c.mutex.Unlock()
+		c.close(wsCloseUnauthorized, "Unauthorized")
+
+		return false
+	}
+
+	if _, ok := c.operations[message.ID]; ok {
+		c.mutex.Unlock()
+		c.close(wsCloseSubscriberExists, "Subscriber for "+message.ID+" already exists")
+
+		return false
+	}
+
+	if limit := c.config.MaxOperations; limit > 0 && len(c.operations) >= limit {
+		c.mutex.Unlock()
+		c.writePayload(message.ID, wsError, formatErrors(
+			fmt.Errorf("%w: the limit is %d", ErrTooManySubscriptions, limit)))
+
+		return true
+	}
+
+	if c.allow != nil && !c.allow(c.ctx) {
+		c.mutex.Unlock()
+		c.writePayload(message.ID, wsError, formatErrors(rateLimitError{}))
+
+		return true
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.operations[message.ID] = cancel
+
+	c.mutex.Unlock()
+
+	go c.execute(ctx, message.ID, payload)
+
+	return true
+}
+
+// fail removes an operation that did not start and sends its errors.
+func (c *wsConnection) fail(id string, errs []gqlerrors.FormattedError) {
+	c.mutex.Lock()
+	if cancel, ok := c.operations[id]; ok {
+		delete(c.operations, id)
+		cancel()
+	}
+	c.mutex.Unlock()
+
+	c.writePayload(id, wsError, errs)
+}
+
+// execute runs an operation, streaming results of subscriptions
+// until the source is exhausted or the client completes it.
+func (c *wsConnection) execute(ctx context.Context, id string, payload operationPayload) {
+	if _, err := c.prepare(&payload); err != nil {
+		c.fail(id, formatErrors(err))
+
+		return
+	}
+
+	if c.allowFields != nil && !c.allowFields(ctx, payload) {
+		c.fail(id, formatErrors(rateLimitError{}))
+
+		return
+	}
+
+	params := graphql.Params{
+		Schema:         c.schema,
+		RequestString:  payload.Query,
+		VariableValues: payload.Variables,
+		OperationName:  payload.OperationName,
+		Context:        ctx,
+	}
+
+	var results chan *graphql.Result
+
+	if operationType(payload.Query, payload.OperationName) == ast.OperationTypeSubscription {
+		results = graphql.Subscribe(params)
+	} else {
+		params.Context = withUserLoader(ctx, c.domain)
+		results = make(chan *graphql.Result, 1)
+		results <- graphql.Do(params)
+		close(results)
+	}
+
+	failed := false
+
+	for result := range results {
+		if failed {
+			continue
+		}
+
+		if result.HasErrors() && result.Data == nil {
+			failed = true
+
+			c.writePayload(id, wsError, result.Errors)
+
+			continue
+		}
+
+		c.writePayload(id, wsNext, result)
+	}
+
+	c.mutex.Lock()
+	cancel, active := c.operations[id]
+	delete(c.operations, id)
+	c.mutex.Unlock()
+
+	if active {
+		cancel()
+
+		if !failed {
+			c.write(wsMessage{ID: id, Type: wsComplete})
+		}
+	}
+}
+
+func (c *wsConnection) ping() {
+	ticker := time.NewTicker(c.config.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			c.write(wsMessage{Type: wsPing})
+		}
+	}
+}
+
+func (c *wsConnection) writePayload(id string, messageType string, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		slogw.DefaultLogger().ErrorContext(c.ctx, "graphql subscriptions",
+			slog.String("payload marshal", err.Error()))
+
+		return
+	}
+
+	c.write(wsMessage{ID: id, Type: messageType, Payload: data})
+}
+
+func (c *wsConnection) write(message wsMessage) {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+
+	if err := c.conn.WriteJSON(message); err != nil {
+		c.cancel()
+	}
+}
+
+func (c *wsConnection) close(code int, reason string) {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+
+	_ = c.conn.WriteControl(websocket.CloseMessage,
+		websocket.FormatCloseMessage(code, reason), time.Now().Add(wsWriteTimeout))
+	_ = c.conn.Close()
+
+	c.cancel()
+}
+
+func operationType(query string, operationName string) string {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return ""
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		if operationName == "" || (operation.Name != nil && operation.Name.Value == operationName) {
+			return operation.Operation
+		}
+	}
+
+	return ""
+}
+
+// userChanged subscribes to user events, filtered by the optional id and country.
+func (c *Controller) userChanged() graphql.FieldResolveFn {
+	return func(params graphql.ResolveParams) (any, error) {
+		if c.subscriber == nil {
+			return nil, fmt.Errorf("user changed subscriber: %w", ErrSubscriptionsDisabled)
+		}
+
+		var input inputMap = params.Args
+
+		var userID string
+		if _, ok := input["id"]; ok {
+			value, err := input.uuidValue("id")
+			if err != nil {
+				return nil, fmt.Errorf("user changed subscriber: %w", err)
+			}
+
+			userID = value.String()
+		}
+
+		country, _ := input.stringValue("country")
+
+		events := c.subscriber.Subscribe(params.Context)
+		source := make(chan any)
+
+		go func() {
+			defer close(source)
+
+			for event := range events {
+				if (userID != "" && event.ID.String() != userID) || (country != "" && event.Country != country) {
+					continue
+				}
+
+				select {
+				case source <- event:
+				case <-params.Context.Done():
+					return
+				}
+			}
+		}()
+
+		return source, nil
+	}
+}
@@ -0,0 +1,161 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+)
+
+type testSubscriber struct {
+	events chan domain.UserEvent
+}
+
+func (s *testSubscriber) Subscribe(ctx context.Context) <-chan domain.UserEvent {
+	events := make(chan domain.UserEvent)
+
+	go func() {
+		defer close(events)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-s.events:
+				events <- event
+			}
+		}
+	}()
+
+	return events
+}
+
+func TestController_Subscriptions(t *testing.T) {
+	t.Parallel()
+
+	subscriber := &testSubscriber{events: make(chan domain.UserEvent)}
+
+	config := Config{Subscriptions: SubscriptionsConfig{Enable: true, InitTimeout: DefaultInitTimeout}}
+
+	controller, err := New(config, nil, subscriber)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(controller.Handler())
+	t.Cleanup(server.Close)
+
+	dialer := websocket.Dialer{Subprotocols: []string{wsProtocol}}
+
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+DefaultPath, nil)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	require.NoError(t, conn.WriteJSON(wsMessage{Type: wsConnectionInit}))
+	assert.Equal(t, wsConnectionAck, readMessage(t, conn).Type)
+
+	payload, err := json.Marshal(operationPayload{
+		Query: `subscription { userChanged(country: "GB") { kind user { id country } } }`,
+	})
+	require.NoError(t, err)
+	require.NoError(t, conn.WriteJSON(wsMessage{ID: "1", Type: wsSubscribe, Payload: payload}))
+
+	userID := uuid.New()
+
+	subscriber.events <- domain.UserEvent{Operation: domain.UserUpdated, User: domain.User{ID: uuid.New(), Country: "US"}}
+	subscriber.events <- domain.UserEvent{Operation: domain.UserCreated, User: domain.User{ID: userID, Country: "GB"}}
+
+	message := readMessage(t, conn)
+	assert.Equal(t, wsNext, message.Type)
+	assert.Equal(t, "1", message.ID)
+	assert.JSONEq(t,
+		`{"data":{"userChanged":{"kind":"CREATED","user":{"id":"`+userID.String()+`","country":"GB"}}}}`,
+		string(message.Payload))
+
+	// A second init closes the connection.
+	require.NoError(t, conn.WriteJSON(wsMessage{Type: wsConnectionInit}))
+
+	_, _, err = conn.ReadMessage()
+
+	var closeErr *websocket.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	assert.Equal(t, wsCloseTooManyInitialize, closeErr.Code)
+}
+
+func TestController_SubscriptionsLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		burst         int
+		maxOperations int
+		expected      string
+	}{
+		// The upgrade takes a token, each operation another one.
+		{name: "rate limited", burst: 3, expected: `"code":"RATE_LIMITED"`},
+		{name: "too many operations", burst: 10, maxOperations: 2, expected: ErrTooManySubscriptions.Error()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter, err := ratelimit.New(ratelimit.Config{Key: ratelimit.KeyIP, Rate: 0.001, Burst: test.burst})
+			require.NoError(t, err)
+
+			config := Config{Subscriptions: SubscriptionsConfig{
+				Enable:        true,
+				InitTimeout:   DefaultInitTimeout,
+				MaxOperations: test.maxOperations,
+			}}
+
+			controller, err := New(config, nil, &testSubscriber{events: make(chan domain.UserEvent)}, WithRateLimiter(limiter))
+			require.NoError(t, err)
+
+			server := httptest.NewServer(controller.Handler())
+			t.Cleanup(server.Close)
+
+			dialer := websocket.Dialer{Subprotocols: []string{wsProtocol}}
+
+			conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+DefaultPath, nil)
+			require.NoError(t, err)
+
+			t.Cleanup(func() { _ = conn.Close() })
+
+			require.NoError(t, conn.WriteJSON(wsMessage{Type: wsConnectionInit}))
+			assert.Equal(t, wsConnectionAck, readMessage(t, conn).Type)
+
+			payload, err := json.Marshal(operationPayload{Query: `subscription { userChanged { kind } }`})
+			require.NoError(t, err)
+
+			for _, id := range []string{"1", "2", "3"} {
+				require.NoError(t, conn.WriteJSON(wsMessage{ID: id, Type: wsSubscribe, Payload: payload}))
+			}
+
+			// The third operation fails, the connection stays open.
+			message := readMessage(t, conn)
+			assert.Equal(t, wsError, message.Type)
+			assert.Equal(t, "3", message.ID)
+			assert.Contains(t, string(message.Payload), test.expected)
+
+			require.NoError(t, conn.WriteJSON(wsMessage{Type: wsPing}))
+			assert.Equal(t, wsPong, readMessage(t, conn).Type)
+		})
+	}
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) wsMessage {
+	t.Helper()
+
+	var message wsMessage
+	require.NoError(t, conn.ReadJSON(&message))
+
+	return message
+}
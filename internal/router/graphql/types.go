@@ -1,13 +1,31 @@
 package graphql
 
 import (
+	"fmt"
+
 	"github.com/graphql-go/graphql"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
 )
 
+//nolint:gochecknoglobals
+var nodeInterface = graphql.NewInterface(graphql.InterfaceConfig{
+	Name: "Node",
+	Fields: graphql.Fields{
+		"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+	},
+})
+
 //nolint:gochecknoglobals
 var userType = graphql.NewObject(graphql.ObjectConfig{
-	Name: "User",
+	Name:       "User",
+	Interfaces: []*graphql.Interface{nodeInterface},
+	IsTypeOf: func(params graphql.IsTypeOfParams) bool {
+		_, ok := userSource(params.Value)
+
+		return ok
+	},
 	Fields: graphql.Fields{
+		// The UUID is unique across all node types, it is the Relay global id as well.
 		"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
 		"firstName": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
 		"lastName":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
@@ -55,3 +73,80 @@ var usersType = graphql.NewObject(graphql.ObjectConfig{
 		"nextPage":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
 	},
 })
+
+//nolint:gochecknoglobals
+var userEventKindType = graphql.NewEnum(graphql.EnumConfig{
+	Name: "UserEventKind",
+	Values: graphql.EnumValueConfigMap{
+		"CREATED": &graphql.EnumValueConfig{Value: domain.UserCreated},
+		"UPDATED": &graphql.EnumValueConfig{Value: domain.UserUpdated},
+		"DELETED": &graphql.EnumValueConfig{Value: domain.UserDeleted},
+	},
+})
+
+//nolint:gochecknoglobals
+var userEventType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "UserEvent",
+	Fields: graphql.Fields{
+		"kind": &graphql.Field{
+			Type: graphql.NewNonNull(userEventKindType),
+			Resolve: func(params graphql.ResolveParams) (any, error) {
+				event, ok := params.Source.(domain.UserEvent)
+				if !ok {
+					return nil, fmt.Errorf("user event kind: %w", ErrTypeAssertion)
+				}
+
+				return event.Operation, nil
+			},
+		},
+		"user": &graphql.Field{
+			Type: graphql.NewNonNull(userType),
+			Resolve: func(params graphql.ResolveParams) (any, error) {
+				event, ok := params.Source.(domain.UserEvent)
+				if !ok {
+					return nil, fmt.Errorf("user event user: %w", ErrTypeAssertion)
+				}
+
+				return event.User, nil
+			},
+		},
+	},
+})
+
+//nolint:gochecknoglobals
+var userFilterType = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name: "UserFilter",
+	Fields: graphql.InputObjectConfigFieldMap{
+		"country": &graphql.InputObjectFieldConfig{Type: graphql.String},
+	},
+})
+
+//nolint:gochecknoglobals
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		"hasNextPage":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"hasPreviousPage": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		"startCursor":     &graphql.Field{Type: graphql.String},
+		"endCursor":       &graphql.Field{Type: graphql.String},
+	},
+})
+
+//nolint:gochecknoglobals
+var userEdgeType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "UserEdge",
+	Fields: graphql.Fields{
+		"node":   &graphql.Field{Type: graphql.NewNonNull(userType)},
+		"cursor": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+	},
+})
+
+//nolint:gochecknoglobals
+var userConnectionType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "UserConnection",
+	Fields: graphql.Fields{
+		"edges":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userEdgeType)))},
+		"pageInfo":   &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+		"totalCount": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+	},
+})
@@ -1,4 +1,28 @@
 package grpc
 
-// TODO: Add config fields if needed.
-type Config struct{}
+import "time"
+
+type Config struct {
+	// Connect enables serving the user service over the Connect,
+	// gRPC and gRPC-Web protocols on an HTTP/1.1 and h2c listener.
+	Connect bool       `yaml:"connect" mapstructure:"Connect"`
+	CORS    CORSConfig `yaml:"cors" mapstructure:"CORS"`
+}
+
+// CORSConfig configures cross-origin requests to the Connect handler.
+// CORS is disabled when no origins are allowed.
+type CORSConfig struct {
+	AllowedOrigins   []string      `yaml:"allowedOrigins" mapstructure:"AllowedOrigins"`
+	AllowedHeaders   []string      `yaml:"allowedHeaders" mapstructure:"AllowedHeaders"`
+	AllowCredentials bool          `yaml:"allowCredentials" mapstructure:"AllowCredentials"`
+	MaxAge           time.Duration `yaml:"maxAge" mapstructure:"MaxAge"`
+}
+
+func Defaults() map[string]any {
+	return map[string]any{
+		"Router.Connect":     false,
+		"Router.CORS.MaxAge": DefaultCORSMaxAge,
+	}
+}
+
+const DefaultCORSMaxAge = 2 * time.Hour
@@ -0,0 +1,387 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	"github.com/rs/cors"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"github.com/yolkhovyy/go-userv/contract/proto/protoconnect"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ConnectHandler returns an HTTP handler serving the user service over
+// the Connect, gRPC and gRPC-Web protocols, on HTTP/1.1 and h2c.
+// It shares the controller and the interceptors with the gRPC server,
+// interceptors run after the shared interceptors as in Options.
+func (c *Controller) ConnectHandler(interceptors Interceptors) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(protoconnect.NewUserServiceHandler(
+		&connectService{controller: c},
+		connect.WithInterceptors(connectInterceptor{
+			unary:  append(unaryInterceptors(), interceptors.Unary...),
+			stream: append(streamInterceptors(), interceptors.Stream...),
+		}),
+	))
+
+	handler := otelhttp.NewHandler(requestid.Handler(mux), "connect")
+
+	if len(c.config.CORS.AllowedOrigins) > 0 {
+		handler = corsHandler(c.config.CORS).Handler(handler)
+	}
+
+	return h2c.NewHandler(handler, &http2.Server{})
+}
+
+// connectService adapts the gRPC controller to the Connect handler interface.
+type connectService struct {
+	controller *Controller
+}
+
+func (s *connectService) Create(
+	ctx context.Context, req *connect.Request[proto.UserInput],
+) (*connect.Response[proto.User], error) {
+	return connectResponse(s.controller.Create(ctx, req.Msg))
+}
+
+func (s *connectService) Update(
+	ctx context.Context, req *connect.Request[proto.UserUpdate],
+) (*connect.Response[proto.User], error) {
+	return connectResponse(s.controller.Update(ctx, req.Msg))
+}
+
+func (s *connectService) Get(
+	ctx context.Context, req *connect.Request[proto.UserID],
+) (*connect.Response[proto.User], error) {
+	return connectResponse(s.controller.Get(ctx, req.Msg))
+}
+
+func (s *connectService) List(
+	ctx context.Context, req *connect.Request[proto.ListRequest],
+) (*connect.Response[proto.Users], error) {
+	return connectResponse(s.controller.List(ctx, req.Msg))
+}
+
+func (s *connectService) Delete(
+	ctx context.Context, req *connect.Request[proto.UserID],
+) (*connect.Response[empty.Empty], error) {
+	return connectResponse(s.controller.Delete(ctx, req.Msg))
+}
+
+func (s *connectService) Export(
+	ctx context.Context, req *connect.Request[proto.ExportRequest], stream *connect.ServerStream[proto.User],
+) error {
+	if err := s.controller.export(ctx, req.Msg, stream.Send); err != nil {
+		return connectError(err)
+	}
+
+	return nil
+}
+
+func connectResponse[T any](msg *T, err error) (*connect.Response[T], error) {
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse(msg), nil
+}
+
+// connectInterceptor runs the gRPC server interceptors around Connect calls.
+// The interceptors see the request headers as incoming metadata and the client
+// address as peer, the header metadata they set is sent as response headers.
+type connectInterceptor struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+func (i connectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		var resp connect.AnyResponse
+
+		handler := func(ctx context.Context, _ any) (any, error) {
+			var err error
+
+			resp, err = next(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return resp.Any(), nil
+		}
+
+		info := &grpc.UnaryServerInfo{FullMethod: req.Spec().Procedure}
+		transport := &connectTransportStream{method: info.FullMethod}
+		ctx = grpc.NewContextWithServerTransportStream(
+			connectContext(ctx, req.Header(), req.Peer()), transport)
+
+		for j := len(i.unary) - 1; j >= 0; j-- {
+			interceptor, inner := i.unary[j], handler
+			handler = func(ctx context.Context, msg any) (any, error) {
+				return interceptor(ctx, msg, info, inner)
+			}
+		}
+
+		msg, err := handler(ctx, req.Any())
+		if err != nil {
+			connectErr := asConnectError(err)
+			copyMetadata(connectErr.Meta(), transport.header)
+
+			return nil, connectErr
+		}
+
+		// Interceptors may respond without calling the handler, e.g. replaying a stored response.
+		if resp == nil || resp.Any() != msg {
+			if resp = connectAnyResponse(msg); resp == nil {
+				return nil, connect.NewError(connect.CodeInternal, ErrUnexpectedResponse)
+			}
+		}
+
+		copyMetadata(resp.Header(), transport.header)
+		copyMetadata(resp.Trailer(), transport.trailer)
+
+		return resp, nil
+	}
+}
+
+// connectAnyResponse wraps the response messages of the user service,
+// it returns nil for other messages.
+func connectAnyResponse(msg any) connect.AnyResponse {
+	switch msg := msg.(type) {
+	case *proto.User:
+		return connect.NewResponse(msg)
+	case *proto.Users:
+		return connect.NewResponse(msg)
+	case *empty.Empty:
+		return connect.NewResponse(msg)
+	}
+
+	return nil
+}
+
+// connectContext puts the request headers in the context as incoming metadata
+// and the client address as peer.
+func connectContext(ctx context.Context, header http.Header, client connect.Peer) context.Context {
+	md := metadata.MD{}
+	for name, values := range header {
+		md.Append(strings.ToLower(name), values...)
+	}
+
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	if addr, err := net.ResolveTCPAddr("tcp", client.Addr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	return ctx
+}
+
+// connectTransportStream collects the header metadata set by the interceptors
+// and handlers of a Connect call with grpc.SetHeader and grpc.SetTrailer.
+type connectTransportStream struct {
+	method  string
+	mutex   sync.Mutex
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *connectTransportStream) Method() string {
+	return s.method
+}
+
+func (s *connectTransportStream) SetHeader(md metadata.MD) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.header = metadata.Join(s.header, md)
+
+	return nil
+}
+
+func (s *connectTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *connectTransportStream) SetTrailer(md metadata.MD) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.trailer = metadata.Join(s.trailer, md)
+
+	return nil
+}
+
+func (i connectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i connectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		spec := conn.Spec()
+
+		handler := func(_ any, stream grpc.ServerStream) error {
+			return next(stream.Context(), &connectStreamingConn{StreamingHandlerConn: conn, stream: stream})
+		}
+
+		info := &grpc.StreamServerInfo{
+			FullMethod:     spec.Procedure,
+			IsClientStream: spec.StreamType&connect.StreamTypeClient != 0,
+			IsServerStream: spec.StreamType&connect.StreamTypeServer != 0,
+		}
+
+		for j := len(i.stream) - 1; j >= 0; j-- {
+			interceptor, inner := i.stream[j], handler
+			handler = func(srv any, stream grpc.ServerStream) error {
+				return interceptor(srv, stream, info, inner)
+			}
+		}
+
+		ctx = connectContext(ctx, conn.RequestHeader(), conn.Peer())
+
+		if err := handler(nil, &connectServerStream{ctx: ctx, conn: conn}); err != nil {
+			return connectError(err)
+		}
+
+		return nil
+	}
+}
+
+// connectServerStream adapts a Connect streaming connection to grpc.ServerStream
+// for the gRPC stream interceptors.
+type connectServerStream struct {
+	ctx  context.Context //nolint:containedctx
+	conn connect.StreamingHandlerConn
+}
+
+func (s *connectServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// SetHeader adds md to the response headers, sent with the first message.
+func (s *connectServerStream) SetHeader(md metadata.MD) error {
+	copyMetadata(s.conn.ResponseHeader(), md)
+
+	return nil
+}
+
+func (s *connectServerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *connectServerStream) SetTrailer(md metadata.MD) {
+	copyMetadata(s.conn.ResponseTrailer(), md)
+}
+
+func (s *connectServerStream) SendMsg(m any) error {
+	return s.conn.Send(m) //nolint:wrapcheck
+}
+
+func (s *connectServerStream) RecvMsg(m any) error {
+	return s.conn.Receive(m) //nolint:wrapcheck
+}
+
+// connectStreamingConn passes the messages of a Connect stream through the
+// grpc.ServerStream wrapped by the interceptors, e.g. to validate requests.
+type connectStreamingConn struct {
+	connect.StreamingHandlerConn
+	stream grpc.ServerStream
+}
+
+func (c *connectStreamingConn) Receive(m any) error {
+	return c.stream.RecvMsg(m) //nolint:wrapcheck
+}
+
+func (c *connectStreamingConn) Send(m any) error {
+	return c.stream.SendMsg(m) //nolint:wrapcheck
+}
+
+func copyMetadata(header http.Header, md metadata.MD) {
+	for key, values := range md {
+		for _, value := range values {
+			header.Add(key, value)
+		}
+	}
+}
+
+// connectError converts gRPC status errors, including their details, to Connect errors.
+func connectError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+
+	if _, ok := status.FromError(err); !ok {
+		return err
+	}
+
+	return asConnectError(err)
+}
+
+// asConnectError converts any error to a Connect error, errors without
+// a gRPC status are Unknown.
+func asConnectError(err error) *connect.Error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	sts, _ := status.FromError(err)
+
+	connectErr = connect.NewError(connect.Code(sts.Code()), errors.New(sts.Message()))
+
+	for _, detail := range sts.Details() {
+		msg, ok := detail.(protobuf.Message)
+		if !ok {
+			continue
+		}
+
+		if errorDetail, err := connect.NewErrorDetail(msg); err == nil {
+			connectErr.AddDetail(errorDetail)
+		}
+	}
+
+	return connectErr
+}
+
+func corsHandler(config CORSConfig) *cors.Cors {
+	return cors.New(cors.Options{
+		AllowedOrigins: config.AllowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders: append([]string{
+			"Content-Type",
+			"Connect-Protocol-Version",
+			"Connect-Timeout-Ms",
+			"Connect-Accept-Encoding",
+			"Connect-Content-Encoding",
+			"Grpc-Timeout",
+			"X-Grpc-Web",
+			"X-User-Agent",
+			requestid.Header,
+			IdempotencyKeyMetadata,
+		}, config.AllowedHeaders...),
+		ExposedHeaders: []string{
+			requestid.Header,
+			IdempotentReplayedMetadata,
+			"Grpc-Status",
+			"Grpc-Message",
+			"Grpc-Status-Details-Bin",
+			"Content-Encoding",
+			"Connect-Content-Encoding",
+		},
+		AllowCredentials: config.AllowCredentials,
+		MaxAge:           int(config.MaxAge.Seconds()),
+	})
+}
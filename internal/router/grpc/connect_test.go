@@ -0,0 +1,246 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"github.com/yolkhovyy/go-userv/contract/proto/protoconnect"
+	"github.com/yolkhovyy/go-userv/internal/contract/domain"
+	"github.com/yolkhovyy/go-userv/internal/contract/storage"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+func TestController_ConnectHandler(t *testing.T) {
+	t.Parallel()
+
+	gotUser := domain.User(storage.User{
+		ID:        uuid.New(),
+		FirstName: "John",
+		LastName:  "Doe",
+		Nickname:  "john.doe",
+		Email:     "john.doe@example.com",
+		Country:   "GB",
+	})
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		Get(mock.Anything, gotUser.ID).
+		Return(&gotUser, nil)
+
+	controller := New(Config{Connect: true}, &mockDomain)
+
+	server := httptest.NewServer(controller.ConnectHandler(Interceptors{}))
+	t.Cleanup(server.Close)
+
+	protocols := map[string][]connect.ClientOption{
+		"connect":  nil,
+		"grpc-web": {connect.WithGRPCWeb()},
+	}
+
+	for name, opts := range protocols {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := protoconnect.NewUserServiceClient(http.DefaultClient, server.URL, opts...)
+
+			resp, err := client.Get(context.Background(), connect.NewRequest(&proto.UserID{Id: gotUser.ID.String()}))
+			require.NoError(t, err)
+			assert.Equal(t, gotUser.Email, resp.Msg.GetEmail())
+
+			_, err = client.Get(context.Background(), connect.NewRequest(&proto.UserID{}))
+			require.Error(t, err)
+			assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+			var connectErr *connect.Error
+			require.ErrorAs(t, err, &connectErr)
+			require.Len(t, connectErr.Details(), 1)
+
+			detail, err := connectErr.Details()[0].Value()
+			require.NoError(t, err)
+
+			badRequest, ok := detail.(*errdetails.BadRequest)
+			require.True(t, ok)
+			assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+		})
+	}
+}
+
+func TestController_ConnectHandlerStream(t *testing.T) {
+	t.Parallel()
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		Export(mock.Anything, "GB", mock.Anything).
+		Run(func(context.Context, string, func(domain.User) error) {
+			panic("export failed")
+		})
+
+	controller := New(Config{Connect: true}, &mockDomain)
+
+	server := httptest.NewServer(controller.ConnectHandler(Interceptors{}))
+	t.Cleanup(server.Close)
+
+	client := protoconnect.NewUserServiceClient(http.DefaultClient, server.URL)
+
+	t.Run("panic recovered", func(t *testing.T) {
+		t.Parallel()
+
+		stream, err := client.Export(context.Background(), connect.NewRequest(&proto.ExportRequest{Country: "GB"}))
+		require.NoError(t, err)
+
+		for stream.Receive() {
+		}
+
+		require.Error(t, stream.Err())
+		assert.Equal(t, connect.CodeUnknown, connect.CodeOf(stream.Err()))
+		assert.Contains(t, stream.Err().Error(), "recovered from panic")
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		stream, err := client.Export(context.Background(), connect.NewRequest(&proto.ExportRequest{Country: "gb"}))
+		require.NoError(t, err)
+
+		assert.False(t, stream.Receive())
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+	})
+}
+
+func TestController_ConnectHandlerIdempotency(t *testing.T) {
+	t.Parallel()
+
+	createdUser := domain.User(storage.User{
+		ID:        uuid.New(),
+		FirstName: "John",
+		LastName:  "Doe",
+		Nickname:  "john.doe",
+		Email:     "john.doe@example.com",
+		Country:   "GB",
+	})
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(&createdUser, nil).
+		Once()
+
+	store := idempotency.NewMemory(idempotency.Config{TTL: time.Hour, LockTimeout: time.Minute})
+	controller := New(Config{Connect: true}, &mockDomain)
+
+	server := httptest.NewServer(controller.ConnectHandler(Interceptors{
+		Unary: []grpc.UnaryServerInterceptor{IdempotencyInterceptor(store, nil)},
+	}))
+	t.Cleanup(server.Close)
+
+	client := protoconnect.NewUserServiceClient(http.DefaultClient, server.URL)
+
+	create := func() (*connect.Response[proto.User], error) {
+		req := connect.NewRequest(&proto.UserInput{
+			FirstName: "John",
+			LastName:  "Doe",
+			Nickname:  "john.doe",
+			Email:     "john.doe@example.com",
+			Country:   "GB",
+		})
+		req.Header().Set(IdempotencyKeyMetadata, "8e6b3c1a")
+
+		return client.Create(context.Background(), req)
+	}
+
+	first, err := create()
+	require.NoError(t, err)
+	assert.Empty(t, first.Header().Get(IdempotentReplayedMetadata))
+
+	retry, err := create()
+	require.NoError(t, err)
+	assert.Equal(t, "true", retry.Header().Get(IdempotentReplayedMetadata))
+	assert.Equal(t, first.Msg.GetId(), retry.Msg.GetId())
+
+	mockDomain.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestController_ConnectHandlerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	gotUser := domain.User(storage.User{ID: uuid.New(), Email: "john.doe@example.com"})
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		Get(mock.Anything, gotUser.ID).
+		Return(&gotUser, nil)
+
+	limiter, err := ratelimit.New(ratelimit.Config{Key: ratelimit.KeyIP, Rate: 0.5, Burst: 1})
+	require.NoError(t, err)
+
+	controller := New(Config{Connect: true}, &mockDomain)
+
+	server := httptest.NewServer(controller.ConnectHandler(Interceptors{
+		Unary: []grpc.UnaryServerInterceptor{RateLimitInterceptor(limiter)},
+	}))
+	t.Cleanup(server.Close)
+
+	client := protoconnect.NewUserServiceClient(http.DefaultClient, server.URL)
+	req := connect.NewRequest(&proto.UserID{Id: gotUser.ID.String()})
+
+	resp, err := client.Get(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "0", resp.Header().Get("RateLimit-Remaining"))
+
+	_, err = client.Get(context.Background(), req)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	assert.Equal(t, "2", connectErr.Meta().Get("Retry-After"))
+}
+
+func TestController_ConnectHandlerStreamRateLimit(t *testing.T) {
+	t.Parallel()
+
+	mockDomain := domain.MockContract{}
+	mockDomain.EXPECT().
+		Export(mock.Anything, "GB", mock.Anything).
+		Return(nil)
+
+	limiter, err := ratelimit.New(ratelimit.Config{Key: ratelimit.KeyIP, Rate: 0.5, Burst: 1})
+	require.NoError(t, err)
+
+	controller := New(Config{Connect: true}, &mockDomain)
+
+	server := httptest.NewServer(controller.ConnectHandler(Interceptors{
+		Stream: []grpc.StreamServerInterceptor{RateLimitStreamInterceptor(limiter)},
+	}))
+	t.Cleanup(server.Close)
+
+	client := protoconnect.NewUserServiceClient(http.DefaultClient, server.URL)
+
+	export := func() *connect.ServerStreamForClient[proto.User] {
+		stream, err := client.Export(context.Background(), connect.NewRequest(&proto.ExportRequest{Country: "GB"}))
+		require.NoError(t, err)
+
+		for stream.Receive() {
+		}
+
+		return stream
+	}
+
+	stream := export()
+	require.NoError(t, stream.Err())
+	assert.Equal(t, "0", stream.ResponseHeader().Get("RateLimit-Remaining"))
+
+	stream = export()
+	assert.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(stream.Err()))
+}
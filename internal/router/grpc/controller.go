@@ -7,12 +7,14 @@ import (
 
 type Controller struct {
 	domain domain.Contract
+	config Config
 	proto.UnimplementedUserServiceServer
 }
 
-func New(_ Config, domain domain.Contract) *Controller {
+func New(config Config, domain domain.Contract) *Controller {
 	user := Controller{
 		domain: domain,
+		config: config,
 	}
 
 	return &user
@@ -2,4 +2,7 @@ package grpc
 
 import "errors"
 
-var ErrPanic = errors.New("panic")
+var (
+	ErrPanic              = errors.New("panic")
+	ErrUnexpectedResponse = errors.New("unexpected response")
+)
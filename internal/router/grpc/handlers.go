@@ -24,10 +24,6 @@ func (c *Controller) Create(ctx context.Context, req *proto.UserInput) (*proto.U
 		Password:  req.GetPassword(),
 	}
 
-	if err := userInput.Validate(); err != nil {
-		return nil, fmt.Errorf("create user: %w", err)
-	}
-
 	createdUser, err := c.domain.Create(ctx, dto.UserInputToDomain(userInput))
 	if err != nil {
 		return nil, fmt.Errorf("create user: %w", err)
@@ -37,15 +33,9 @@ func (c *Controller) Create(ctx context.Context, req *proto.UserInput) (*proto.U
 }
 
 func (c *Controller) Get(ctx context.Context, req *proto.UserID) (*proto.User, error) {
-	var err error
-
-	var userID uuid.UUID
-
-	if req.GetId() != "" {
-		userID, err = uuid.Parse(req.GetId())
-		if err != nil {
-			return nil, fmt.Errorf("user id: %w", err)
-		}
+	userID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("user id: %w", err)
 	}
 
 	user, err := c.domain.Get(ctx, userID)
@@ -101,10 +91,6 @@ func (c *Controller) Update(ctx context.Context, req *proto.UserUpdate) (*proto.
 		Password:  req.GetPassword(),
 	}
 
-	if err := userUpdate.Validate(); err != nil {
-		return nil, fmt.Errorf("update user: %w", err)
-	}
-
 	updatedUser, err := c.domain.Update(ctx, dto.UserUpdateToDomain(userUpdate))
 	if err != nil {
 		return nil, fmt.Errorf("update user: %w", err)
@@ -131,6 +117,22 @@ func (c *Controller) Delete(ctx context.Context, req *proto.UserID) (*empty.Empt
 	return &empty.Empty{}, nil
 }
 
+func (c *Controller) Export(req *proto.ExportRequest, stream proto.UserService_ExportServer) error {
+	return c.export(stream.Context(), req, stream.Send)
+}
+
+// export streams users to send until done, send fails or ctx is cancelled.
+func (c *Controller) export(ctx context.Context, req *proto.ExportRequest, send func(*proto.User) error) error {
+	err := c.domain.Export(ctx, req.GetCountry(), func(user domain.User) error {
+		return send(userToProto(&user))
+	})
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+
+	return nil
+}
+
 func userToProto(user *domain.User) *proto.User {
 	return &proto.User{
 		Id:        user.ID.String(),
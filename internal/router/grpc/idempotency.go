@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+const (
+	IdempotencyKeyMetadata     = "idempotency-key"
+	IdempotentReplayedMetadata = "idempotent-replayed"
+	idempotencyContentType     = "application/protobuf"
+)
+
+// idempotentMethods create the empty responses of the methods honouring idempotency keys.
+//
+//nolint:gochecknoglobals
+var idempotentMethods = map[string]func() protobuf.Message{
+	proto.UserService_Create_FullMethodName: func() protobuf.Message { return &proto.User{} },
+	proto.UserService_Update_FullMethodName: func() protobuf.Message { return &proto.User{} },
+}
+
+// IdempotencyInterceptor replays the stored response of Create and Update calls
+// retried with the same idempotency-key metadata. Only successful responses are
+// stored, the key of a failed call is released so that it can be retried.
+// Keys are scoped to the client as identified by the rate limiter, by peer IP
+// without one, so that clients cannot replay the responses of each other.
+func IdempotencyInterceptor(store idempotency.Store, limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		newResponse, ok := idempotentMethods[info.FullMethod]
+		msg, isMessage := req.(protobuf.Message)
+
+		if !ok || !isMessage {
+			return handler(ctx, req)
+		}
+
+		keys := metadata.ValueFromIncomingContext(ctx, IdempotencyKeyMetadata)
+		if len(keys) == 0 || keys[0] == "" {
+			return handler(ctx, req)
+		}
+
+		if err := idempotency.ValidateKey(keys[0]); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		key := idempotency.ClientKey(limiter.ClientID(rateLimitClient(ctx, limiter)), keys[0])
+
+		fingerprint, err := requestFingerprint(info.FullMethod, msg)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		stored, err := store.Begin(ctx, key, fingerprint)
+		if err != nil {
+			return nil, idempotencyError(err)
+		}
+
+		if stored != nil {
+			return replay(ctx, stored, newResponse())
+		}
+
+		resp, err := handler(ctx, req)
+
+		// Store the response even when the client went away meanwhile.
+		storeCtx := context.WithoutCancel(ctx)
+
+		var storeErr error
+
+		if respMsg, ok := resp.(protobuf.Message); err == nil && ok {
+			var body []byte
+
+			if body, storeErr = protobuf.Marshal(respMsg); storeErr == nil {
+				storeErr = store.Complete(storeCtx, key, idempotency.Response{
+					Status:      int(codes.OK),
+					ContentType: idempotencyContentType,
+					Body:        body,
+				})
+			}
+		} else {
+			storeErr = store.Release(storeCtx, key)
+		}
+
+		if storeErr != nil {
+			slogw.DefaultLogger().ErrorContext(ctx, "idempotency",
+				slog.String("key", keys[0]),
+				slog.String("store", storeErr.Error()),
+			)
+		}
+
+		return resp, err
+	}
+}
+
+func requestFingerprint(method string, msg protobuf.Message) (string, error) {
+	body, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("request marshal: %w", err)
+	}
+
+	return idempotency.Fingerprint([]byte(method), body), nil
+}
+
+func replay(ctx context.Context, stored *idempotency.Response, resp protobuf.Message) (any, error) {
+	if err := protobuf.Unmarshal(stored.Body, resp); err != nil {
+		return nil, status.Error(codes.Internal, "stored response unmarshal")
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayedMetadata, "true"))
+
+	return resp, nil
+}
+
+func idempotencyError(err error) error {
+	switch {
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, idempotency.ErrInProgress):
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	return status.Error(codes.Internal, "idempotency store")
+}
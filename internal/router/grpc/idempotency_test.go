@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"github.com/yolkhovyy/go-userv/internal/idempotency"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	store := idempotency.NewMemory(idempotency.Config{TTL: time.Hour, LockTimeout: time.Minute})
+	limiter, err := ratelimit.New(ratelimit.Config{
+		Key:          ratelimit.KeyAPIKey,
+		APIKeyHeader: ratelimit.DefaultAPIKeyHeader,
+		APIKeys:      []string{"alice"},
+		Rate:         1,
+		Burst:        1,
+	})
+	require.NoError(t, err)
+
+	interceptor := IdempotencyInterceptor(store, limiter)
+
+	var calls int
+
+	handler := func(_ context.Context, req any) (any, error) {
+		calls++
+
+		input, _ := req.(*proto.UserInput)
+
+		return &proto.User{Id: "42", FirstName: input.GetFirstName()}, nil
+	}
+
+	callFrom := func(ip, apiKey, key, method string, req *proto.UserInput) (any, error) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+
+		md := metadata.MD{}
+		if key != "" {
+			md.Set(IdempotencyKeyMetadata, key)
+		}
+
+		if apiKey != "" {
+			md.Set(ratelimit.DefaultAPIKeyHeader, apiKey)
+		}
+
+		return interceptor(metadata.NewIncomingContext(ctx, md), req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+
+	call := func(key, method string, req *proto.UserInput) (any, error) {
+		return callFrom("192.0.2.1", "", key, method, req)
+	}
+
+	create := proto.UserService_Create_FullMethodName
+	input := &proto.UserInput{FirstName: "John"}
+
+	first, err := call("key", create, input)
+	require.NoError(t, err)
+
+	retry, err := call("key", create, input)
+	require.NoError(t, err)
+	assert.True(t, protobuf.Equal(first.(protobuf.Message), retry.(protobuf.Message))) //nolint:forcetypeassert
+	assert.Equal(t, 1, calls)
+
+	_, err = call("key", create, &proto.UserInput{FirstName: "Jane"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Keys are scoped to clients, another client does not get the response.
+	_, err = callFrom("192.0.2.2", "", "key", create, input)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	// Clients with a known API key are identified by the key across IPs.
+	_, err = callFrom("192.0.2.3", "alice", "key", create, input)
+	require.NoError(t, err)
+
+	replayed, err := callFrom("192.0.2.4", "alice", "key", create, input)
+	require.NoError(t, err)
+	assert.True(t, protobuf.Equal(first.(protobuf.Message), replayed.(protobuf.Message))) //nolint:forcetypeassert
+	assert.Equal(t, 3, calls)
+
+	// Calls without a key and other methods are not deduplicated.
+	_, err = call("", create, input)
+	require.NoError(t, err)
+
+	_, err = call("key", proto.UserService_Get_FullMethodName, input)
+	require.NoError(t, err)
+	assert.Equal(t, 5, calls)
+}
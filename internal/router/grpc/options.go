@@ -8,33 +8,59 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
 )
 
-func Options() []grpc.ServerOption {
-	logOpts := []logging.Option{
-		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
-	}
+// Interceptors run after the shared interceptors, e.g. RateLimitInterceptor
+// and IdempotencyInterceptor.
+type Interceptors struct {
+	Unary  []grpc.UnaryServerInterceptor
+	Stream []grpc.StreamServerInterceptor
+}
 
+// Options returns the gRPC server options, interceptors run after the
+// shared interceptors.
+func Options(interceptors Interceptors) []grpc.ServerOption {
 	traceHandler := otelgrpc.NewServerHandler(
 		otelgrpc.WithTracerProvider(otel.GetTracerProvider()),
 	)
 
 	return []grpc.ServerOption{
-		grpc.ChainUnaryInterceptor(
-			recovery.UnaryServerInterceptor(panicRecovery),
-			logging.UnaryServerInterceptor(logging.LoggerFunc(slogWrapper), logOpts...),
-		),
-		grpc.ChainStreamInterceptor(
-			recovery.StreamServerInterceptor(panicRecovery),
-			logging.StreamServerInterceptor(logging.LoggerFunc(slogWrapper), logOpts...),
-		),
+		grpc.ChainUnaryInterceptor(append(unaryInterceptors(), interceptors.Unary...)...),
+		grpc.ChainStreamInterceptor(append(streamInterceptors(), interceptors.Stream...)...),
 		grpc.StatsHandler(traceHandler),
 	}
 }
 
+// unaryInterceptors are shared by the gRPC server and the Connect handler.
+func unaryInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		requestIDInterceptor,
+		recovery.UnaryServerInterceptor(panicRecovery),
+		logging.UnaryServerInterceptor(logging.LoggerFunc(slogWrapper), logOptions()...),
+		validationInterceptor,
+	}
+}
+
+// streamInterceptors are shared by the gRPC server and the Connect handler.
+func streamInterceptors() []grpc.StreamServerInterceptor {
+	return []grpc.StreamServerInterceptor{
+		requestIDStreamInterceptor,
+		recovery.StreamServerInterceptor(panicRecovery),
+		logging.StreamServerInterceptor(logging.LoggerFunc(slogWrapper), logOptions()...),
+		validationStreamInterceptor,
+	}
+}
+
+func logOptions() []logging.Option {
+	return []logging.Option{
+		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
+	}
+}
+
 //nolint:gochecknoglobals
 var panicRecovery = recovery.WithRecoveryHandler(func(p any) error {
 	return fmt.Errorf("%v recovered from panic: %w", p, ErrPanic)
@@ -52,6 +78,8 @@ func slogWrapper(ctx context.Context, lvl logging.Level, msg string, fields ...a
 		attrs = append(attrs, slog.Any(key, fields[i+1]))
 	}
 
+	attrs = append(attrs, requestid.Attr(ctx))
+
 	// Convert grpc-middleware log level to slog log level
 	var slogLevel slog.Level
 
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimitInterceptor limits the calls of clients per method, limited calls fail
+// with ResourceExhausted and RetryInfo details. Calls are let through when the
+// store fails. The RateLimit headers are sent as header metadata.
+func RateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	allow := rateLimit(limiter)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		header, err := allow(ctx, info.FullMethod)
+		if len(header) > 0 {
+			_ = grpc.SetHeader(ctx, header)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// RateLimitStreamInterceptor limits the streams of clients per method as RateLimitInterceptor,
+// a stream takes one token when it starts.
+func RateLimitStreamInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	allow := rateLimit(limiter)
+
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		header, err := allow(stream.Context(), info.FullMethod)
+		if len(header) > 0 {
+			_ = stream.SetHeader(header)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+// rateLimit returns a function taking a token of the client calling method,
+// it returns the RateLimit headers and an error when the call is limited.
+func rateLimit(limiter *ratelimit.Limiter) func(ctx context.Context, method string) (metadata.MD, error) {
+	return func(ctx context.Context, method string) (metadata.MD, error) {
+		result, err := limiter.Allow(ctx, method, rateLimitClient(ctx, limiter))
+		if err != nil {
+			slogw.DefaultLogger().ErrorContext(ctx, "rate limit",
+				slog.String("allow", err.Error()),
+			)
+
+			return nil, nil
+		}
+
+		header := http.Header{}
+		ratelimit.SetHeaders(header, result)
+
+		md := metadata.MD{}
+		for name, values := range header {
+			md.Set(name, values...)
+		}
+
+		if !result.Allowed {
+			return md, rateLimitError(result)
+		}
+
+		return md, nil
+	}
+}
+
+// rateLimitClient identifies the client of a call by its peer address and API key metadata.
+// A nil limiter does not read API keys.
+func rateLimitClient(ctx context.Context, limiter *ratelimit.Limiter) ratelimit.Client {
+	client := ratelimit.Client{}
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client.IP = ratelimit.HostIP(p.Addr.String())
+	}
+
+	if limiter == nil {
+		return client
+	}
+
+	if keys := metadata.ValueFromIncomingContext(ctx, strings.ToLower(limiter.APIKeyHeader())); len(keys) > 0 {
+		client.APIKey = keys[0]
+	}
+
+	return client
+}
+
+func rateLimitError(result ratelimit.Result) error {
+	sts, err := status.New(codes.ResourceExhausted, ratelimit.ErrLimited.Error()).
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(result.RetryAfter)})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, ratelimit.ErrLimited.Error())
+	}
+
+	return sts.Err()
+}
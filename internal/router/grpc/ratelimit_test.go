@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"github.com/yolkhovyy/go-userv/internal/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	limiter, err := ratelimit.New(ratelimit.Config{
+		Key:          ratelimit.KeyAPIKey,
+		APIKeyHeader: ratelimit.DefaultAPIKeyHeader,
+		APIKeys:      []string{"alice", "bob"},
+		Rate:         1,
+		Burst:        1,
+	})
+	require.NoError(t, err)
+
+	interceptor := RateLimitInterceptor(limiter)
+
+	handler := func(_ context.Context, _ any) (any, error) {
+		return &proto.User{Id: "42"}, nil
+	}
+
+	call := func(ip net.IP, apiKey string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: ip, Port: 4242}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", apiKey))
+
+		_, err := interceptor(ctx, &proto.UserID{Id: "42"},
+			&grpc.UnaryServerInfo{FullMethod: proto.UserService_Get_FullMethodName}, handler)
+
+		return err
+	}
+
+	first, second := net.IPv4(10, 0, 0, 1), net.IPv4(10, 0, 0, 2)
+
+	require.NoError(t, call(first, "alice"))
+
+	err = call(first, "alice")
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+
+	retryInfo, ok := details[0].(*errdetails.RetryInfo)
+	require.True(t, ok)
+	assert.Positive(t, retryInfo.GetRetryDelay().AsDuration())
+
+	// Another API key does not lift the IP limit, the API key is limited across IPs.
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(first, "bob")))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(second, "alice")))
+	require.NoError(t, call(net.IPv4(10, 0, 0, 3), "bob"))
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/yolkhovyy/go-userv/internal/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDMetadata carries the request id, generated when the client sends none.
+const RequestIDMetadata = requestid.MetadataKey
+
+// requestIDInterceptor puts the request id of the call in the context and
+// sends it as header metadata. A request id already in the context, set by
+// the Connect handler, takes precedence.
+func requestIDInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+// requestIDStreamInterceptor puts the request id of the stream in its context.
+func requestIDStreamInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &requestIDStream{ServerStream: stream, ctx: withRequestID(stream.Context())})
+}
+
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	if requestid.FromContext(ctx) != "" {
+		return ctx
+	}
+
+	var id string
+	if ids := metadata.ValueFromIncomingContext(ctx, RequestIDMetadata); len(ids) > 0 {
+		id = ids[0]
+	}
+
+	id = requestid.Resolve(id)
+
+	// Fails outside of gRPC server calls, e.g. in Connect handlers.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDMetadata, id))
+
+	return requestid.NewContext(ctx, id)
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestIDInterceptor(t *testing.T) {
+	t.Parallel()
+
+	call := func(ctx context.Context) string {
+		resp, err := requestIDInterceptor(ctx, nil, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, _ any) (any, error) {
+				return requestid.FromContext(ctx), nil
+			})
+		require.NoError(t, err)
+
+		id, _ := resp.(string)
+
+		return id
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDMetadata, "request-42"))
+	assert.Equal(t, "request-42", call(ctx))
+
+	// Request ids set by the Connect handler take precedence.
+	assert.Equal(t, "request-7", call(requestid.NewContext(ctx, "request-7")))
+
+	id := call(context.Background())
+	assert.NotEmpty(t, id)
+	assert.True(t, requestid.Valid(id))
+}
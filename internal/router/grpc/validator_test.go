@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestValidateMessage(t *testing.T) {
+	t.Parallel()
+
+	validInput := func() *proto.UserInput {
+		return &proto.UserInput{
+			FirstName: "John",
+			LastName:  "Doe",
+			Nickname:  "john.doe",
+			Email:     "john.doe@example.com",
+			Country:   "GB",
+			Password:  "securepassword",
+		}
+	}
+
+	invalidInput := validInput()
+	invalidInput.FirstName = ""
+	invalidInput.Email = "john.doe"
+	invalidInput.Country = "gb"
+
+	tests := []struct {
+		name   string
+		msg    protobuf.Message
+		fields []string
+	}{
+		{name: "valid input", msg: validInput()},
+		{name: "invalid input", msg: invalidInput, fields: []string{"first_name", "email", "country"}},
+		{name: "valid id", msg: &proto.UserID{Id: "28a9581d-9d49-4d3f-b0d6-7c49531e353d"}},
+		{name: "empty id", msg: &proto.UserID{}, fields: []string{"id"}},
+		{name: "partial update", msg: &proto.UserUpdate{Id: "28a9581d-9d49-4d3f-b0d6-7c49531e353d", Country: "US"}},
+		{
+			name:   "unassigned country",
+			msg:    &proto.UserUpdate{Id: "28a9581d-9d49-4d3f-b0d6-7c49531e353d", Country: "ZZ"},
+			fields: []string{"country"},
+		},
+		{name: "invalid update", msg: &proto.UserUpdate{Id: "28a9581d", Email: "@"}, fields: []string{"id", "email"}},
+		{name: "valid list", msg: &proto.ListRequest{Page: 1, Limit: 10}},
+		{name: "invalid list", msg: &proto.ListRequest{Limit: 1001, Country: "GBR"}, fields: []string{"page", "limit", "country"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateMessage(test.msg)
+			if len(test.fields) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			sts, ok := status.FromError(err)
+			require.True(t, ok)
+			assert.Equal(t, codes.InvalidArgument, sts.Code())
+			require.Len(t, sts.Details(), 1)
+
+			badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+
+			fields := make([]string, 0, len(badRequest.GetFieldViolations()))
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+
+			assert.Equal(t, test.fields, fields)
+		})
+	}
+}
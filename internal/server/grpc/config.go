@@ -1,18 +1,55 @@
 package grpc
 
+import "time"
+
+// Config configures the gRPC server. Zero limits and keepalive
+// durations leave the corresponding gRPC defaults in place.
 type Config struct {
-	Port       int  `yaml:"port" mapstructure:"Port"`
-	Reflection bool `yaml:"reflection" mapstructure:"Reflection"`
+	Port                 int             `yaml:"port" mapstructure:"Port"`
+	Reflection           bool            `yaml:"reflection" mapstructure:"Reflection"`
+	ShutdownTimeout      time.Duration   `yaml:"shutdownTimeout" mapstructure:"ShutdownTimeout"`
+	MaxRecvMsgSize       int             `yaml:"maxRecvMsgSize" mapstructure:"MaxRecvMsgSize"`
+	MaxSendMsgSize       int             `yaml:"maxSendMsgSize" mapstructure:"MaxSendMsgSize"`
+	MaxConcurrentStreams uint32          `yaml:"maxConcurrentStreams" mapstructure:"MaxConcurrentStreams"`
+	Keepalive            KeepaliveConfig `yaml:"keepalive" mapstructure:"Keepalive"`
+}
+
+// KeepaliveConfig holds the server keepalive parameters and the
+// enforcement policy applied to client pings.
+type KeepaliveConfig struct {
+	// Time after which an idle connection is pinged, Timeout to wait for the ack.
+	Time    time.Duration `yaml:"time" mapstructure:"Time"`
+	Timeout time.Duration `yaml:"timeout" mapstructure:"Timeout"`
+	// Connection lifetime limits.
+	MaxConnectionIdle     time.Duration `yaml:"maxConnectionIdle" mapstructure:"MaxConnectionIdle"`
+	MaxConnectionAge      time.Duration `yaml:"maxConnectionAge" mapstructure:"MaxConnectionAge"`
+	MaxConnectionAgeGrace time.Duration `yaml:"maxConnectionAgeGrace" mapstructure:"MaxConnectionAgeGrace"`
+	// Enforcement: minimum client ping interval, pings without active streams.
+	MinTime             time.Duration `yaml:"minTime" mapstructure:"MinTime"`
+	PermitWithoutStream bool          `yaml:"permitWithoutStream" mapstructure:"PermitWithoutStream"`
 }
 
 func Defaults() map[string]any {
 	return map[string]any{
-		"GRPC.Port":            defaultGRPCPort,
-		"GRPC.ShutdownTimeout": defaultRouterMode,
+		"GRPC.Port":                            DefaultPort,
+		"GRPC.ShutdownTimeout":                 DefaultShutdownTimeout,
+		"GRPC.MaxRecvMsgSize":                  DefaultMaxMsgSize,
+		"GRPC.MaxSendMsgSize":                  DefaultMaxMsgSize,
+		"GRPC.MaxConcurrentStreams":            DefaultMaxConcurrentStreams,
+		"GRPC.Keepalive.Time":                  DefaultKeepaliveTime,
+		"GRPC.Keepalive.Timeout":               DefaultKeepaliveTimeout,
+		"GRPC.Keepalive.MinTime":               DefaultKeepaliveMinTime,
+		"GRPC.Keepalive.MaxConnectionAgeGrace": DefaultMaxConnectionAgeGrace,
 	}
 }
 
 const (
-	defaultGRPCPort   = 50501
-	defaultRouterMode = "release"
+	DefaultPort                  = 50051
+	DefaultShutdownTimeout       = 5 * time.Second
+	DefaultMaxMsgSize            = 4 << 20
+	DefaultMaxConcurrentStreams  = 100
+	DefaultKeepaliveTime         = 2 * time.Hour
+	DefaultKeepaliveTimeout      = 20 * time.Second
+	DefaultKeepaliveMinTime      = 5 * time.Minute
+	DefaultMaxConnectionAgeGrace = 10 * time.Second
 )
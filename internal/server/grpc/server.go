@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
 	"github.com/yolkhovyy/go-userv/contract/proto"
@@ -13,6 +15,7 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -23,11 +26,25 @@ type Server struct {
 
 //nolint:ireturn
 func New(config Config, serviceServer proto.UserServiceServer, opts ...grpc.ServerOption) server.Contract {
+	return &Server{
+		server: newServer(config, serviceServer, opts...),
+		config: config,
+	}
+}
+
+// NewHandler returns the gRPC server as an HTTP handler, serving gRPC on an HTTP/2
+// server shared with other protocols. Port, keepalive and the transport limits
+// of the configuration do not apply, they are up to the HTTP server.
+func NewHandler(config Config, serviceServer proto.UserServiceServer, opts ...grpc.ServerOption) http.Handler {
+	return newServer(config, serviceServer, opts...)
+}
+
+func newServer(config Config, serviceServer proto.UserServiceServer, opts ...grpc.ServerOption) *grpc.Server {
 	serverHandler := otelgrpc.NewServerHandler(
 		otelgrpc.WithTracerProvider(otel.GetTracerProvider()),
 	)
 
-	opts = append(opts, grpc.StatsHandler(serverHandler))
+	opts = append(append(serverOptions(config), opts...), grpc.StatsHandler(serverHandler))
 
 	grpcServer := grpc.NewServer(opts...)
 	proto.RegisterUserServiceServer(grpcServer, serviceServer)
@@ -36,10 +53,7 @@ func New(config Config, serviceServer proto.UserServiceServer, opts ...grpc.Serv
 		reflection.Register(grpcServer)
 	}
 
-	return &Server{
-		server: grpcServer,
-		config: config,
-	}
+	return grpcServer
 }
 
 func (s *Server) Run(ctx context.Context) error {
@@ -74,9 +88,74 @@ func (s *Server) Run(ctx context.Context) error {
 
 	logger.DebugContext(ctx, "grpc server shutting down")
 
-	s.server.GracefulStop()
+	if !s.gracefulStop() {
+		logger.WarnContext(ctx, "grpc server graceful stop timed out",
+			slog.Duration("timeout", s.config.ShutdownTimeout),
+		)
+	}
 
 	logger.DebugContext(ctx, "grpc server shutdown complete")
 
 	return nil
 }
+
+// gracefulStop waits for pending RPCs to finish within the shutdown timeout,
+// then closes all connections forcibly. It reports whether the graceful stop
+// completed in time.
+func (s *Server) gracefulStop() bool {
+	stopped := make(chan struct{})
+
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	if s.config.ShutdownTimeout <= 0 {
+		<-stopped
+
+		return true
+	}
+
+	timer := time.NewTimer(s.config.ShutdownTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		s.server.Stop()
+		<-stopped
+
+		return false
+	}
+}
+
+func serverOptions(config Config) []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     config.Keepalive.MaxConnectionIdle,
+			MaxConnectionAge:      config.Keepalive.MaxConnectionAge,
+			MaxConnectionAgeGrace: config.Keepalive.MaxConnectionAgeGrace,
+			Time:                  config.Keepalive.Time,
+			Timeout:               config.Keepalive.Timeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             config.Keepalive.MinTime,
+			PermitWithoutStream: config.Keepalive.PermitWithoutStream,
+		}),
+	}
+
+	if config.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(config.MaxRecvMsgSize))
+	}
+
+	if config.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(config.MaxSendMsgSize))
+	}
+
+	if config.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(config.MaxConcurrentStreams))
+	}
+
+	return opts
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yolkhovyy/go-userv/contract/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_ShutdownTimeout(t *testing.T) {
+	t.Parallel()
+
+	const shutdownTimeout = 200 * time.Millisecond
+
+	port := freePort(t)
+	service := &blockingService{started: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- New(Config{Port: port, ShutdownTimeout: shutdownTimeout}, service).Run(ctx)
+	}()
+
+	conn, err := grpc.NewClient(net.JoinHostPort("localhost", strconv.Itoa(port)),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	stream, err := proto.NewUserServiceClient(conn).Export(context.Background(), &proto.ExportRequest{},
+		grpc.WaitForReady(true))
+	require.NoError(t, err)
+
+	recvErr := make(chan error, 1)
+
+	go func() {
+		_, err := stream.Recv()
+		recvErr <- err
+	}()
+
+	select {
+	case <-service.started:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "export not started")
+	}
+
+	// GracefulStop waits for the blocked stream, Stop closes it after the timeout.
+	start := time.Now()
+
+	cancel()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(shutdownTimeout + 2*time.Second):
+		require.FailNow(t, "run did not return after the shutdown timeout")
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), shutdownTimeout)
+	assert.Equal(t, codes.Unavailable, status.Code(<-recvErr))
+}
+
+// blockingService blocks Export until the server closes the stream.
+type blockingService struct {
+	proto.UnimplementedUserServiceServer
+	started chan struct{}
+}
+
+func (s *blockingService) Export(_ *proto.ExportRequest, stream grpc.ServerStreamingServer[proto.User]) error {
+	close(s.started)
+	<-stream.Context().Done()
+
+	return stream.Context().Err() //nolint:wrapcheck
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	port := listener.Addr().(*net.TCPAddr).Port //nolint:forcetypeassert
+	require.NoError(t, listener.Close())
+
+	return port
+}
@@ -1,18 +1,46 @@
 package http
 
-import "time"
+import (
+	"net/http"
+	"time"
+)
 
+// Config configures the HTTP server. Zero timeouts are disabled, read and
+// write timeouts also bound streaming responses and WebSocket handshakes.
 type Config struct {
-	Port              int           `yaml:"port" mapstructure:"Port"`
+	// Host is the address listened on, all interfaces when empty.
+	Host string `yaml:"host" mapstructure:"Host"`
+	Port int    `yaml:"port" mapstructure:"Port"`
+	// Socket is the path of a Unix domain socket listened on instead of Port.
+	Socket            string        `yaml:"socket" mapstructure:"Socket"`
 	ShutdownTimeout   time.Duration `yaml:"shutdownTimeout" mapstructure:"ShutdownTimeout"`
 	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout" mapstructure:"ReadHeaderTimeout"`
+	ReadTimeout       time.Duration `yaml:"readTimeout" mapstructure:"ReadTimeout"`
+	WriteTimeout      time.Duration `yaml:"writeTimeout" mapstructure:"WriteTimeout"`
+	IdleTimeout       time.Duration `yaml:"idleTimeout" mapstructure:"IdleTimeout"`
+	MaxHeaderBytes    int           `yaml:"maxHeaderBytes" mapstructure:"MaxHeaderBytes"`
+	// H2C serves cleartext HTTP/2, HTTP/2 is negotiated with TLS.
+	H2C bool      `yaml:"h2c" mapstructure:"H2C"`
+	TLS TLSConfig `yaml:"tls" mapstructure:"TLS"`
+}
+
+// TLSConfig configures TLS termination. The certificate and key files
+// are reloaded when they change, checked every ReloadInterval.
+type TLSConfig struct {
+	Enable         bool          `yaml:"enable" mapstructure:"Enable"`
+	CertFile       string        `yaml:"certFile" mapstructure:"CertFile"`
+	KeyFile        string        `yaml:"keyFile" mapstructure:"KeyFile"`
+	ReloadInterval time.Duration `yaml:"reloadInterval" mapstructure:"ReloadInterval"`
 }
 
 func Defaults() map[string]any {
 	return map[string]any{
-		"HTTP.Port":              DefaultPort,
-		"HTTP.ShutdownTimeout":   DefaultShutdownTimeout,
-		"HTTP.ReadHeaderTimeout": DefaultReadHeaderTimeout,
+		"HTTP.Port":               DefaultPort,
+		"HTTP.ShutdownTimeout":    DefaultShutdownTimeout,
+		"HTTP.ReadHeaderTimeout":  DefaultReadHeaderTimeout,
+		"HTTP.IdleTimeout":        DefaultIdleTimeout,
+		"HTTP.MaxHeaderBytes":     DefaultMaxHeaderBytes,
+		"HTTP.TLS.ReloadInterval": DefaultTLSReloadInterval,
 	}
 }
 
@@ -20,4 +48,7 @@ const (
 	DefaultPort              = 8080
 	DefaultShutdownTimeout   = 5 * time.Second
 	DefaultReadHeaderTimeout = 1 * time.Second
+	DefaultIdleTimeout       = 2 * time.Minute
+	DefaultMaxHeaderBytes    = http.DefaultMaxHeaderBytes
+	DefaultTLSReloadInterval = time.Minute
 )
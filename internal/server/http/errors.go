@@ -0,0 +1,5 @@
+package http
+
+import "errors"
+
+var ErrMissingCertificate = errors.New("tls enabled without certificate or key file")
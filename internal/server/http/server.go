@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"net"
 	"net/http"
+	"os"
 	"strconv"
 
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
 	cserver "github.com/yolkhovyy/go-userv/internal/contract/server"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 type Server struct {
@@ -20,30 +24,66 @@ type Server struct {
 
 //nolint:ireturn
 func New(config Config, handler http.Handler) cserver.Contract {
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	if config.Socket != "" {
+		addr = config.Socket
+	}
+
+	if config.H2C && !config.TLS.Enable {
+		handler = h2c.NewHandler(handler, &http2.Server{IdleTimeout: config.IdleTimeout})
+	}
+
 	return &Server{
 		Server: &http.Server{
-			Addr:              net.JoinHostPort("", strconv.Itoa(config.Port)),
+			Addr:              addr,
 			Handler:           handler,
 			ReadHeaderTimeout: config.ReadHeaderTimeout,
+			ReadTimeout:       config.ReadTimeout,
+			WriteTimeout:      config.WriteTimeout,
+			IdleTimeout:       config.IdleTimeout,
+			MaxHeaderBytes:    config.MaxHeaderBytes,
 		},
 		config: config,
 	}
 }
 
+//nolint:funlen
 func (s *Server) Run(ctx context.Context) error {
 	logger := slogw.DefaultLogger()
 
-	errChan := make(chan error)
+	var cert *certificate
+
+	if s.config.TLS.Enable {
+		var err error
+
+		if cert, err = newCertificate(s.config.TLS); err != nil {
+			return fmt.Errorf("http server tls: %w", err)
+		}
+
+		s.Server.TLSConfig = cert.tlsConfig()
+	}
+
+	listener, err := s.listen()
+	if err != nil {
+		return fmt.Errorf("http server listen: %w", err)
+	}
+
+	if cert != nil {
+		go cert.watch(ctx)
+	}
+
+	errChan := make(chan error, 1)
 
 	go func() {
 		logger.InfoContext(ctx, "http server starting",
-			slog.String("addr", s.Server.Addr))
+			slog.String("addr", listener.Addr().String()),
+			slog.Bool("tls", cert != nil))
 
-		if err := s.Server.ListenAndServe(); err != nil {
-			errChan <- err
+		if cert != nil {
+			errChan <- s.Server.ServeTLS(listener, "", "")
+		} else {
+			errChan <- s.Server.Serve(listener)
 		}
-
-		close(errChan)
 	}()
 
 	select {
@@ -56,10 +96,11 @@ func (s *Server) Run(ctx context.Context) error {
 
 	logger.DebugContext(ctx, "http server shutting down")
 
-	ctx, timeout := context.WithTimeout(ctx, s.config.ShutdownTimeout)
-	defer timeout()
+	// ctx is done, the shutdown gets its own deadline.
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.config.ShutdownTimeout)
+	defer cancel()
 
-	if err := s.Server.Shutdown(ctx); err != nil {
+	if err := s.Server.Shutdown(shutdownCtx); err != nil {
 		return fmt.Errorf("http server shutdown: %w", err)
 	}
 
@@ -67,3 +108,35 @@ func (s *Server) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// listen listens on the Unix domain socket, replacing a stale one, or on the TCP port.
+//
+//nolint:ireturn
+func (s *Server) listen() (net.Listener, error) {
+	if s.config.Socket == "" {
+		listener, err := net.Listen("tcp", s.Server.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("net listen: %w", err)
+		}
+
+		return listener, nil
+	}
+
+	info, err := os.Lstat(s.config.Socket)
+
+	switch {
+	case err == nil && info.Mode()&fs.ModeSocket != 0:
+		if err := os.Remove(s.config.Socket); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	case err != nil && !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("socket stat: %w", err)
+	}
+
+	listener, err := net.Listen("unix", s.config.Socket)
+	if err != nil {
+		return nil, fmt.Errorf("net listen: %w", err)
+	}
+
+	return listener, nil
+}
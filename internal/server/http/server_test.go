@@ -0,0 +1,190 @@
+package http
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cserver "github.com/yolkhovyy/go-userv/internal/contract/server"
+)
+
+func TestServer_Socket(t *testing.T) {
+	t.Parallel()
+
+	socket := filepath.Join(t.TempDir(), "http.sock")
+
+	config := Config{Socket: socket, ShutdownTimeout: time.Second}
+	client := socketClient(socket, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, New(config, hello()))
+
+	assert.Equal(t, "HTTP/1.1 hello", get(t, client, "http://socket/"))
+
+	// The shutdown completes although ctx is cancelled.
+	cancel()
+	require.NoError(t, <-done)
+
+	_, err := os.Stat(socket)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestServer_Host(t *testing.T) {
+	t.Parallel()
+
+	srv, ok := New(Config{Host: "127.0.0.1", Port: 8090}, hello()).(*Server)
+	require.True(t, ok)
+	assert.Equal(t, "127.0.0.1:8090", srv.Addr)
+
+	srv, ok = New(Config{Port: 8090}, hello()).(*Server)
+	require.True(t, ok)
+	assert.Equal(t, ":8090", srv.Addr)
+}
+
+func TestServer_TLSReload(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	socket := filepath.Join(dir, "https.sock")
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+
+	writeCertificate(t, certFile, keyFile, "first")
+
+	config := Config{
+		Socket:          socket,
+		ShutdownTimeout: time.Second,
+		TLS: TLSConfig{
+			Enable:         true,
+			CertFile:       certFile,
+			KeyFile:        keyFile,
+			ReloadInterval: 10 * time.Millisecond,
+		},
+	}
+
+	var commonName string
+
+	client := socketClient(socket, &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection: func(state tls.ConnectionState) error {
+			commonName = state.PeerCertificates[0].Subject.CommonName
+
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, New(config, hello()))
+
+	// HTTP/2 is negotiated over TLS.
+	assert.Equal(t, "HTTP/2.0 hello", get(t, client, "https://socket/"))
+	assert.Equal(t, "first", commonName)
+
+	// Newer files replace the certificate.
+	writeCertificate(t, certFile, keyFile, "second")
+
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, future, future))
+
+	assert.Eventually(t, func() bool {
+		client.CloseIdleConnections()
+		get(t, client, "https://socket/")
+
+		return commonName == "second"
+	}, 5*time.Second, 20*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+func TestServer_TLSMissingCertificate(t *testing.T) {
+	t.Parallel()
+
+	server := New(Config{TLS: TLSConfig{Enable: true}}, hello())
+	assert.ErrorIs(t, server.Run(context.Background()), ErrMissingCertificate)
+}
+
+func hello() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = io.WriteString(writer, request.Proto+" hello")
+	})
+}
+
+func run(ctx context.Context, server cserver.Contract) <-chan error {
+	done := make(chan error, 1)
+
+	go func() { done <- server.Run(ctx) }()
+
+	return done
+}
+
+func socketClient(socket string, tlsConfig *tls.Config) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socket)
+			},
+			TLSClientConfig:   tlsConfig,
+			ForceAttemptHTTP2: true,
+		},
+		Timeout: time.Second,
+	}
+}
+
+// get retries until the server listens.
+func get(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+
+	var body []byte
+
+	require.Eventually(t, func() bool {
+		resp, err := client.Get(url) //nolint:noctx
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+
+		body, err = io.ReadAll(resp.Body)
+
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return string(body)
+}
+
+func writeCertificate(t *testing.T, certFile, keyFile, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/yolkhovyy/go-otelw/otelw/slogw"
+)
+
+// certificate serves the certificate loaded from the TLS files,
+// replaced when the files change.
+type certificate struct {
+	config  TLSConfig
+	mutex   sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+func newCertificate(config TLSConfig) (*certificate, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, ErrMissingCertificate
+	}
+
+	c := certificate{config: config}
+
+	if _, err := c.reload(); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+func (c *certificate) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: c.get,
+	}
+}
+
+func (c *certificate) get(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.cert, nil
+}
+
+// watch reloads the certificate when its files change until ctx is done,
+// the current certificate is kept when the files can not be loaded.
+func (c *certificate) watch(ctx context.Context) {
+	if c.config.ReloadInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.config.ReloadInterval)
+	defer ticker.Stop()
+
+	logger := slogw.DefaultLogger()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := c.reload()
+
+			switch {
+			case err != nil:
+				logger.ErrorContext(ctx, "http server tls",
+					slog.String("certificate reload", err.Error()))
+			case reloaded:
+				logger.InfoContext(ctx, "http server tls",
+					slog.String("certificate reloaded", c.config.CertFile))
+			}
+		}
+	}
+}
+
+// reload loads the certificate when its files changed since the last load.
+func (c *certificate) reload() (bool, error) {
+	modTime, err := c.filesModTime()
+	if err != nil {
+		return false, err
+	}
+
+	c.mutex.RLock()
+	unchanged := c.cert != nil && !modTime.After(c.modTime)
+	c.mutex.RUnlock()
+
+	if unchanged {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.config.CertFile, c.config.KeyFile)
+	if err != nil {
+		return false, fmt.Errorf("load certificate: %w", err)
+	}
+
+	c.mutex.Lock()
+	c.cert, c.modTime = &cert, modTime
+	c.mutex.Unlock()
+
+	return true, nil
+}
+
+// filesModTime returns the latest modification time of the certificate and key files.
+func (c *certificate) filesModTime() (time.Time, error) {
+	var modTime time.Time
+
+	for _, file := range []string{c.config.CertFile, c.config.KeyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("certificate file: %w", err)
+		}
+
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+
+	return modTime, nil
+}
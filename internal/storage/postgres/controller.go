@@ -18,6 +18,18 @@ type Controller struct {
 
 //nolint:ireturn
 func New(ctx context.Context, config Config) (storage.Contract, error) {
+	pool, err := NewPool(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Controller{
+		pool: pool,
+	}, nil
+}
+
+// NewPool connects a traced connection pool to the database.
+func NewPool(ctx context.Context, config Config) (*pgxpool.Pool, error) {
 	// TODO: fix sslmode
 	connString := "postgres://" +
 		config.Username + ":" +
@@ -39,9 +51,7 @@ func New(ctx context.Context, config Config) (storage.Contract, error) {
 
 	slogw.DefaultLogger().DebugContext(ctx, "database connected")
 
-	return &Controller{
-		pool: pool,
-	}, nil
+	return pool, nil
 }
 
 func (c *Controller) Close() error {
@@ -54,3 +64,28 @@ func (c *Controller) Close() error {
 
 	return nil
 }
+
+// PoolStats are the connection pool statistics reported with health checks.
+type PoolStats struct {
+	Total    int32 `json:"total"`
+	Idle     int32 `json:"idle"`
+	Acquired int32 `json:"acquired"`
+	Max      int32 `json:"max"`
+}
+
+// Check pings the database and reports the connection pool statistics.
+func (c *Controller) Check(ctx context.Context) (any, error) {
+	stat := c.pool.Stat()
+	stats := PoolStats{
+		Total:    stat.TotalConns(),
+		Idle:     stat.IdleConns(),
+		Acquired: stat.AcquiredConns(),
+		Max:      stat.MaxConns(),
+	}
+
+	if err := c.pool.Ping(ctx); err != nil {
+		return stats, fmt.Errorf("database ping: %w", err)
+	}
+
+	return stats, nil
+}
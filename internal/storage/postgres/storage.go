@@ -2,14 +2,18 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/yolkhovyy/go-otelw/otelw/slogw"
 	"github.com/yolkhovyy/go-userv/internal/contract/storage"
+	"github.com/yolkhovyy/go-userv/internal/requestid"
 )
 
 func (c *Controller) Create(ctx context.Context, user storage.UserInput) (*storage.User, error) {
@@ -18,15 +22,19 @@ func (c *Controller) Create(ctx context.Context, user storage.UserInput) (*stora
 		VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 		RETURNING id, first_name, last_name, nickname, email, country, created_at, updated_at`
 
-	row := c.pool.QueryRow(ctx, query,
-		uuid.New(), user.FirstName, user.LastName, user.Nickname,
-		user.Password, user.Email, user.Country)
-
 	createdUser := &storage.User{}
-	if err := row.Scan(&createdUser.ID, &createdUser.FirstName, &createdUser.LastName,
-		&createdUser.Nickname, &createdUser.Email, &createdUser.Country,
-		&createdUser.CreatedAt, &createdUser.UpdatedAt); err != nil {
-		return nil, fmt.Errorf("create user: %w", err)
+
+	err := c.withRequestID(ctx, func(db querier) error {
+		row := db.QueryRow(ctx, query,
+			uuid.New(), user.FirstName, user.LastName, user.Nickname,
+			user.Password, user.Email, user.Country)
+
+		return row.Scan(&createdUser.ID, &createdUser.FirstName, &createdUser.LastName,
+			&createdUser.Nickname, &createdUser.Email, &createdUser.Country,
+			&createdUser.CreatedAt, &createdUser.UpdatedAt)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create user: %w", classify(err))
 	}
 
 	return createdUser, nil
@@ -45,14 +53,18 @@ func (c *Controller) Update(ctx context.Context, user storage.UserUpdate) (*stor
 		WHERE id = $1
 		RETURNING id, first_name, last_name, nickname, email, country, created_at, updated_at`
 
-	row := c.pool.QueryRow(ctx, query,
-		user.ID, user.FirstName, user.LastName, user.Nickname,
-		user.Password, user.Email, user.Country)
-
 	updatedUser := storage.User{}
-	if err := row.Scan(&updatedUser.ID, &updatedUser.FirstName, &updatedUser.LastName, &updatedUser.Nickname,
-		&updatedUser.Email, &updatedUser.Country, &updatedUser.CreatedAt, &updatedUser.UpdatedAt); err != nil {
-		return nil, fmt.Errorf("update user: %w", err)
+
+	err := c.withRequestID(ctx, func(db querier) error {
+		row := db.QueryRow(ctx, query,
+			user.ID, user.FirstName, user.LastName, user.Nickname,
+			user.Password, user.Email, user.Country)
+
+		return row.Scan(&updatedUser.ID, &updatedUser.FirstName, &updatedUser.LastName, &updatedUser.Nickname,
+			&updatedUser.Email, &updatedUser.Country, &updatedUser.CreatedAt, &updatedUser.UpdatedAt)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update user: %w", classify(err))
 	}
 
 	return &updatedUser, nil
@@ -65,12 +77,25 @@ func (c *Controller) Get(ctx context.Context, userID uuid.UUID) (*storage.User,
 		FROM users WHERE id = $1`
 
 	if err := pgxscan.Get(ctx, c.pool, &user, query, userID); err != nil {
-		return nil, fmt.Errorf("get user: %w", err)
+		return nil, fmt.Errorf("get user: %w", classify(err))
 	}
 
 	return &user, nil
 }
 
+func (c *Controller) GetMany(ctx context.Context, userIDs []uuid.UUID) ([]storage.User, error) {
+	var users []storage.User
+
+	query := `SELECT id, first_name, last_name, nickname, email, country, created_at, updated_at
+		FROM users WHERE id = ANY($1)`
+
+	if err := pgxscan.Select(ctx, c.pool, &users, query, userIDs); err != nil {
+		return nil, fmt.Errorf("get users: %w", err)
+	}
+
+	return users, nil
+}
+
 func (c *Controller) List(ctx context.Context, page int, limit int, countryCode string) ([]storage.User, int, error) {
 	logger := slogw.DefaultLogger()
 
@@ -116,10 +141,133 @@ func (c *Controller) List(ctx context.Context, page int, limit int, countryCode
 	return result, count, nil
 }
 
+// Export reads users through a server-side cursor in a repeatable read
+// transaction, fetching exportBatchSize rows at a time, so that a slow
+// consumer holds back further reads instead of buffering the whole table.
+func (c *Controller) Export(ctx context.Context, countryCode string, yield func(storage.User) error) error {
+	logger := slogw.DefaultLogger()
+
+	trx, err := c.txBeginRepeatableRead(ctx)
+	if err != nil {
+		return fmt.Errorf("export users transaction: %w", err)
+	}
+
+	defer func() {
+		if err := trx.Rollback(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			logger.ErrorContext(ctx, "transaction",
+				slog.String("rollback", err.Error()),
+			)
+		}
+	}()
+
+	query := `DECLARE users_export NO SCROLL CURSOR FOR
+		SELECT id, first_name, last_name, nickname, email, country, created_at, updated_at
+		FROM users WHERE $1 = '' OR country = $1 ORDER BY created_at DESC`
+
+	if _, err := trx.Exec(ctx, query, countryCode); err != nil {
+		return fmt.Errorf("declare cursor: %w", err)
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM users_export", exportBatchSize)
+
+	for {
+		var batch []storage.User
+		if err := pgxscan.Select(ctx, trx, &batch, fetch); err != nil {
+			return fmt.Errorf("fetch users: %w", err)
+		}
+
+		for _, user := range batch {
+			if err := yield(user); err != nil {
+				return fmt.Errorf("export user: %w", err)
+			}
+		}
+
+		if len(batch) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+const exportBatchSize = 500
+
+// Page reads a keyset page on (created_at, id). Backward pages are read
+// in ascending order and reversed. One extra row tells if more rows follow.
+//
+//nolint:funlen
+func (c *Controller) Page(ctx context.Context, query storage.PageQuery) (*storage.UserPage, error) {
+	logger := slogw.DefaultLogger()
+
+	trx, err := c.txBeginRepeatableRead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("page users transaction: %w", err)
+	}
+
+	defer func() {
+		if err := trx.Rollback(ctx); err != nil {
+			logger.ErrorContext(ctx, "transaction",
+				slog.String("rollback", err.Error()),
+			)
+		}
+	}()
+
+	page := storage.UserPage{}
+
+	where := `WHERE ($1 = '' OR country = $1)`
+	args := []any{query.Country}
+
+	if err := pgxscan.Get(ctx, trx, &page.TotalCount, `SELECT COUNT(*) FROM users `+where, args...); err != nil {
+		return nil, fmt.Errorf("count users: %w", err)
+	}
+
+	if query.After != nil {
+		args = append(args, query.After.CreatedAt, query.After.ID)
+		where += fmt.Sprintf(` AND (created_at, id) < ($%d, $%d)`, len(args)-1, len(args))
+	}
+
+	if query.Before != nil {
+		args = append(args, query.Before.CreatedAt, query.Before.ID)
+		where += fmt.Sprintf(` AND (created_at, id) > ($%d, $%d)`, len(args)-1, len(args))
+	}
+
+	backward := query.First == 0
+
+	order, limit := "DESC", query.First
+	if backward {
+		order, limit = "ASC", query.Last
+	}
+
+	args = append(args, limit+1)
+	sql := `SELECT id, first_name, last_name, nickname, email, country, created_at, updated_at FROM users ` +
+		where + fmt.Sprintf(` ORDER BY created_at %s, id %s LIMIT $%d`, order, order, len(args))
+
+	if err := pgxscan.Select(ctx, trx, &page.Users, sql, args...); err != nil {
+		return nil, fmt.Errorf("page users: %w", err)
+	}
+
+	more := len(page.Users) > limit
+	if more {
+		page.Users = page.Users[:limit]
+	}
+
+	if backward {
+		slices.Reverse(page.Users)
+
+		page.HasPrevious, page.HasNext = more, query.Before != nil
+	} else {
+		page.HasNext, page.HasPrevious = more, query.After != nil
+	}
+
+	return &page, nil
+}
+
 func (c *Controller) Delete(ctx context.Context, userID uuid.UUID) error {
 	query := `DELETE FROM users WHERE id = $1`
 
-	_, err := c.pool.Exec(ctx, query, userID)
+	err := c.withRequestID(ctx, func(db querier) error {
+		_, err := db.Exec(ctx, query, userID)
+
+		return err //nolint:wrapcheck
+	})
 	if err != nil {
 		return fmt.Errorf("delete user: %w", err)
 	}
@@ -127,6 +275,49 @@ func (c *Controller) Delete(ctx context.Context, userID uuid.UUID) error {
 	return nil
 }
 
+// querier is implemented by the connection pool and transactions.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// withRequestID runs fn in a transaction setting app.request_id to the request id
+// of ctx, the users trigger adds it to change notifications. Without a request id
+// fn runs on the pool. Errors of fn are returned as is, to be classified.
+func (c *Controller) withRequestID(ctx context.Context, fn func(db querier) error) error {
+	id := requestid.FromContext(ctx)
+	if id == "" {
+		return fn(c.pool)
+	}
+
+	trx, err := c.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err := trx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			slogw.DefaultLogger().ErrorContext(ctx, "transaction",
+				slog.String("rollback", err.Error()),
+			)
+		}
+	}()
+
+	if _, err := trx.Exec(ctx, `SELECT set_config('app.request_id', $1, true)`, id); err != nil {
+		return fmt.Errorf("set request id: %w", err)
+	}
+
+	if err := fn(trx); err != nil {
+		return err
+	}
+
+	if err := trx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 //nolint:ireturn
 func (c *Controller) txBeginRepeatableRead(ctx context.Context) (pgx.Tx, error) {
 	trx, err := c.pool.Begin(ctx)
@@ -145,3 +336,20 @@ func (c *Controller) txBeginRepeatableRead(ctx context.Context) (pgx.Tx, error)
 
 	return trx, nil
 }
+
+// uniqueViolation is the PostgreSQL unique_violation error code.
+const uniqueViolation = "23505"
+
+// classify marks missing rows and unique constraint violations with the storage errors.
+func classify(err error) error {
+	var pgErr *pgconn.PgError
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows) || pgxscan.NotFound(err):
+		return fmt.Errorf("%w: %w", storage.ErrNotFound, err)
+	case errors.As(err, &pgErr) && pgErr.Code == uniqueViolation:
+		return fmt.Errorf("%w: %w", storage.ErrConflict, err)
+	}
+
+	return err
+}
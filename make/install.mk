@@ -11,6 +11,8 @@ install-tools: ## Install required project tools
 	@go install github.com/wadey/gocovmerge@latest
 	@go install google.golang.org/protobuf/cmd/protoc-gen-go@latest
 	@go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
+	@go install github.com/bufbuild/buf/cmd/buf@latest
+	@go install connectrpc.com/connect/cmd/protoc-gen-connect-go@latest
 	@go install github.com/fullstorydev/grpcurl/cmd/grpcurl@latest
 	@go install github.com/99designs/gqlgen@latest
 	@go install github.com/loov/goda@latest
@@ -43,6 +43,7 @@ func (s *testSuiteGRPC) SetupSuite() {
 		{name: "CreateUsers", testFunc: s.createUsers, numUsers: 100},
 		{name: "GetUpdateDeleteUser", testFunc: s.getUpdateDeleteUser, numUsers: 100},
 		{name: "ListUsers", testFunc: s.listUsers, numUsers: 100, pageSize: 12},
+		{name: "ExportUsers", testFunc: s.exportUsers, numUsers: 100},
 		{name: "DeleteAll", testFunc: s.deleteAllUsers, numUsers: 100},
 	}
 	s.createCountry = "ZB"
@@ -163,6 +164,21 @@ func (s *testSuiteGRPC) listUsers(t *testing.T, tcase testCaseGRPC) {
 	}
 }
 
+func (s *testSuiteGRPC) exportUsers(t *testing.T, tcase testCaseGRPC) {
+	list, err := s.client.List(context.Background(), 1, tcase.numUsers+1, s.createCountry)
+	require.NoError(t, err)
+
+	var exported []dto.User
+
+	err = s.client.Export(context.Background(), s.createCountry, func(user dto.User) error {
+		exported = append(exported, user)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, list.Users, exported)
+}
+
 func (s *testSuiteGRPC) deleteAllUsers(t *testing.T, tcase testCaseGRPC) {
 	list, err := s.client.List(context.Background(), 1, tcase.numUsers+1, s.createCountry)
 	require.NoError(t, err)
@@ -1,3 +1,5 @@
 #!/bin/bash
 
 curl -X GET http://localhost:8080/health
+curl -X GET http://localhost:8080/livez
+curl -X GET http://localhost:8080/readyz
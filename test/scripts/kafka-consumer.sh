@@ -3,4 +3,5 @@
 docker compose exec -it kafka kafka-console-consumer \
 --bootstrap-server kafka:9092 \
 --topic postgres.public.users \
---from-beginning
+--from-beginning \
+--property print.headers=true